@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+)
+
+// runConfig inspects the configuration. "config print" writes the effective configuration,
+// after the config file, environment and defaults are merged, with secrets redacted.
+func runConfig(_ context.Context, args []string) error {
+	fs := newFlagSet("config")
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 1 || rest[0] != "print" {
+		return usagef("config takes one argument: print")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("config load: %w", err)
+	}
+	return cfg.Print(os.Stdout)
+}
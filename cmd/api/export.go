@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/export"
+)
+
+// runExport writes the CV stored in the database as a PDF, Markdown, JSON or LaTeX document.
+func runExport(ctx context.Context, args []string) error {
+	fs := newFlagSet("export")
+	format := fs.String("format", export.FormatPDF, "document format: "+strings.Join(export.Formats, ", "))
+	output := fs.String("output", "", "file to write, instead of stdout")
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usagef("export takes no arguments")
+	}
+	if !slices.Contains(export.Formats, *format) {
+		return usagef("unknown format %q", *format)
+	}
+
+	_, a, err := setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer a.Close()
+
+	cv, err := a.CvService.GetCV(ctx)
+	if err != nil {
+		return fmt.Errorf("loading CV: %w", err)
+	}
+
+	if *output == "" {
+		return export.Write(os.Stdout, *format, cv)
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := export.Write(f, *format, cv); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing %s: %w", *output, err)
+	}
+	return f.Close()
+}
@@ -2,72 +2,180 @@ package main
 
 import (
 	"context"
-	_ "embed"
 	"errors"
+	"flag"
 	"fmt"
-	web "github.com/guillermoBallester/go-platform-cv/internal/adapter/handler/http"
-	"github.com/guillermoBallester/go-platform-cv/internal/app"
-	"github.com/guillermoBallester/go-platform-cv/internal/config"
-	"github.com/guillermoBallester/go-platform-cv/sql"
-	"log"
-	"net/http"
+	"io"
+	"log/slog"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/logging"
+	"github.com/guillermoBallester/go-platform-cv/internal/app"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
 )
 
+// Exit codes, so CI and deploy scripts can tell a usage mistake from a failed run.
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+// command is a subcommand of the binary.
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(ctx context.Context, args []string) error
+}
+
+var commands = []command{
+	{"serve", "serve", "apply migrations, optionally seed, and serve HTTP (default)", runServe},
+	{"migrate", "migrate up|down|status|redo|create NAME [--dir DIR]", "manage database migrations", runMigrate},
+	{"seed", "seed [--dry-run] [--prune] [--strict]", "apply the seed files", runSeed},
+	{"export", "export --format pdf|md|json|tex [--output FILE]", "export the CV as a document", runExport},
+	{"validate-seeds", "validate-seeds [--source DIR|URL]", "check the seed files without a database", runValidateSeeds},
+	{"build-static", "build-static [--output DIR] [--source DIR|URL] [--base-url URL]", "render the CV as a static site without a database", runBuildStatic},
+	{"snapshot", "snapshot create [--label L] | list | show | diff", "freeze the CV into a snapshot; show and diff take versions or labels", runSnapshot},
+	{"config", "config print", "print the effective configuration, secrets redacted", runConfig},
+}
+
+// usageError reports a malformed command line; it exits with exitUsage.
+type usageError struct {
+	msg string
+}
+
+func (e usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...any) error {
+	return usageError{msg: fmt.Sprintf(format, args...)}
+}
+
 func main() {
-	if err := run(); err != nil {
-		log.Printf("Startup error: %v", err)
-		os.Exit(1)
-	}
+	os.Exit(run(os.Args[1:]))
 }
 
-func run() error {
+func run(args []string) int {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("config load: %w", err)
+	// --config, before the command, names the config file and takes precedence over CONFIG_FILE.
+	global := newFlagSet("global")
+	global.SetOutput(io.Discard)
+	configFile := global.String("config", "", "YAML or TOML config file (default CONFIG_FILE)")
+	if err := global.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			printUsage()
+			return exitOK
+		}
+		fmt.Fprintln(os.Stderr, err)
+		printUsage()
+		return exitUsage
+	}
+	args = global.Args()
+	if *configFile != "" {
+		if err := os.Setenv("CONFIG_FILE", *configFile); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitFailure
+		}
 	}
 
-	a, err := app.New(ctx, cfg)
-	if err != nil {
-		return fmt.Errorf("app init: %w", err)
+	// Without a subcommand the binary serves, as it always has.
+	name := "serve"
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+	if name == "help" || name == "-h" || name == "--help" {
+		printUsage()
+		return exitOK
 	}
 
-	defer func() {
-		log.Println("Closing DB connection pool...")
-		a.DB.Close()
-	}()
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		err := cmd.run(ctx, args)
+		var usageErr usageError
+		switch {
+		case err == nil:
+			return exitOK
+		case errors.Is(err, flag.ErrHelp):
+			return exitOK
+		case errors.As(err, &usageErr):
+			fmt.Fprintf(os.Stderr, "%s\nusage: %s %s\n", err, filepath.Base(os.Args[0]), cmd.usage)
+			return exitUsage
+		default:
+			slog.Error("command failed", "command", name, "error", err)
+			return exitFailure
+		}
+	}
 
-	if err := sql.RunMigrations(a.DB); err != nil {
-		return fmt.Errorf("migrations: %w", err)
+	fmt.Fprintf(os.Stderr, "unknown command %q\n", name)
+	printUsage()
+	return exitUsage
+}
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "usage: %s [--config FILE] <command> [arguments]\n\ncommands:\n", filepath.Base(os.Args[0]))
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-50s %s\n", cmd.usage, cmd.summary)
 	}
+}
 
-	if cfg.App.SeedData {
-		if err := a.SeedSvc.Run(ctx); err != nil {
-			log.Printf("SeedSvc warning: %v", err)
+// newFlagSet creates a flag set for a subcommand. Parse errors are returned rather than exiting,
+// and are reported as usage errors by parseArgs.
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet(name, flag.ContinueOnError)
+}
+
+// parseArgs parses the arguments of a subcommand and returns its positional arguments. Flags may
+// appear before, between or after them. Malformed flags are reported as a usageError.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, usageError{msg: err.Error()}
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
 		}
+		positional = append(positional, args[0])
+		args = args[1:]
 	}
+}
 
-	router := web.NewRouter(a.CvService)
-	srv := web.NewServer(cfg, router)
-	go func() {
-		if err := srv.Run(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			log.Printf("Server runtime error: %v", err)
-			stop()
+// isFlagSet returns true if the named flag was given on the command line.
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
 		}
-	}()
+	})
+	return set
+}
 
-	<-ctx.Done()
-	log.Println("Shutdown signal received...")
+// setup loads the configuration and wires the application. The caller must close a.DB.
+func setup(ctx context.Context) (*config.Config, *app.App, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("config load: %w", err)
+	}
+	logging.Setup(cfg.App, os.Stderr)
 
-	if err := srv.Shutdown(context.Background()); err != nil {
-		return fmt.Errorf("server shutdown: %w", err)
+	a, err := app.New(ctx, cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("app init: %w", err)
 	}
 
-	log.Println("Server exited successfully")
-	return nil
+	return cfg, a, nil
 }
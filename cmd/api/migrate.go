@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/sql"
+	"github.com/pressly/goose/v3"
+)
+
+// runMigrate manages the database schema: up, down, status, redo, or create a new migration.
+func runMigrate(ctx context.Context, args []string) error {
+	fs := newFlagSet("migrate")
+	dir := fs.String("dir", sql.MigrationsDir, "directory new migrations are created in")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		return usagef("migrate needs an action")
+	}
+	action, rest := positional[0], positional[1:]
+
+	if action == "create" {
+		// Creating a migration only writes a file, so it needs neither configuration nor a database.
+		if len(rest) != 1 {
+			return usagef("migrate create needs exactly one NAME")
+		}
+		return sql.CreateMigration(*dir, rest[0])
+	}
+
+	switch action {
+	case "up", "down", "status", "redo":
+	default:
+		return usagef("unknown migrate action %q", action)
+	}
+	if len(rest) > 0 {
+		return usagef("migrate %s takes no arguments", action)
+	}
+
+	_, a, err := setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer a.Close()
+
+	migrator := a.Migrator
+	switch action {
+	case "up":
+		results, err := migrator.Up(ctx)
+		printMigrationResults(results)
+		if err == nil && len(results) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return err
+	case "down":
+		result, err := migrator.Down(ctx)
+		if result != nil {
+			printMigrationResults([]*goose.MigrationResult{result})
+		}
+		return err
+	case "redo":
+		results, err := migrator.Redo(ctx)
+		printMigrationResults(results)
+		return err
+	default:
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printMigrationStatus(statuses)
+		return nil
+	}
+}
+
+// migrateUp applies every pending migration, logging an event for each one and a summary.
+func migrateUp(ctx context.Context, migrator *sql.Migrator) error {
+	start := time.Now()
+	results, err := migrator.Up(ctx)
+	for _, r := range results {
+		slog.InfoContext(ctx, "migration applied",
+			"version", r.Source.Version,
+			"path", r.Source.Path,
+			"duration_ms", r.Duration.Milliseconds(),
+		)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "migrations failed", "applied", len(results), "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "migrations complete", "applied", len(results), "duration_ms", time.Since(start).Milliseconds())
+	return nil
+}
+
+func printMigrationResults(results []*goose.MigrationResult) {
+	for _, r := range results {
+		state := "OK"
+		if r.Error != nil {
+			state = "FAILED"
+		}
+		fmt.Printf("%-6s %-4s %s (%s)\n", state, r.Direction, r.Source.Path, r.Duration.Round(time.Millisecond))
+	}
+}
+
+func printMigrationStatus(statuses []*goose.MigrationStatus) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "APPLIED AT\tMIGRATION")
+	for _, s := range statuses {
+		appliedAt := strings.ToUpper(string(s.State))
+		if s.State == goose.StateApplied {
+			appliedAt = s.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\n", appliedAt, s.Source.Path)
+	}
+	_ = w.Flush()
+}
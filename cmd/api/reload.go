@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	web "github.com/guillermoBallester/go-platform-cv/internal/adapter/handler/http"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/watcher"
+	"github.com/guillermoBallester/go-platform-cv/internal/app"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+)
+
+// startHotReload watches the theme directory and, when seeds are read from a directory, the
+// seed files. Template changes are re-parsed and seed changes re-run the seeder, without a
+// restart. It is meant for development only; a failing watcher is logged and never stops the
+// server.
+func startHotReload(ctx context.Context, cfg *config.Config, a *app.App, router *web.Router) {
+	if cfg.Theme.Dir == "" {
+		slog.Info("hot reload: templates not watched; set THEME_DIR to a directory such as ./ui/themes/default")
+	} else {
+		startTemplateReload(ctx, cfg, router)
+	}
+
+	seedDir := cfg.Seed.Dir()
+	if !cfg.Seed.Enabled || seedDir == "" {
+		slog.Info("hot reload: seed files not watched; set SEED_DATA=true and SEED_SOURCE to a directory such as ./sql/data")
+		return
+	}
+
+	seeds, err := watcher.New([]string{seedDir}, cfg.App.ReloadDebounce, func(ctx context.Context, changed []string) {
+		slog.InfoContext(ctx, "hot reload: re-seeding", "changed", changed)
+		report, err := a.SeedSvc.Run(ctx, service.SeedOptions{
+			Strict: cfg.Seed.Strict,
+			Prune:  cfg.Seed.Prune,
+			DryRun: cfg.Seed.DryRun,
+		})
+		logSeedReport(ctx, report)
+		a.Metrics.ObserveSeedRun(report, err)
+		if err != nil {
+			slog.WarnContext(ctx, "hot reload: seed run failed", "error", err)
+		}
+	})
+	if err != nil {
+		slog.Warn("hot reload: seed files not watched", "error", err)
+		return
+	}
+	go seeds.Run(ctx)
+}
+
+// startTemplateReload watches the theme directory, its partials and its assets.
+func startTemplateReload(ctx context.Context, cfg *config.Config, router *web.Router) {
+	dirs := []string{cfg.Theme.Dir}
+	for _, sub := range []string{"partials", web.AssetsDir} {
+		dir := filepath.Join(cfg.Theme.Dir, sub)
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	templates, err := watcher.New(dirs, cfg.App.ReloadDebounce, func(_ context.Context, changed []string) {
+		if err := router.ReloadTemplates(); err != nil {
+			slog.Warn("hot reload failed", "error", err)
+			return
+		}
+		slog.Info("hot reload: templates reloaded", "changed", changed)
+	})
+	if err != nil {
+		slog.Warn("hot reload: templates not watched", "error", err)
+		return
+	}
+	go templates.Run(ctx)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+)
+
+// runSeed applies the seed files once. Flags override SEED_DRY_RUN, SEED_PRUNE and SEED_STRICT.
+// A strict run that reports problems exits non-zero.
+func runSeed(ctx context.Context, args []string) error {
+	fs := newFlagSet("seed")
+	dryRun := fs.Bool("dry-run", false, "report the changes without committing them (default SEED_DRY_RUN)")
+	prune := fs.Bool("prune", false, "delete records missing from the seed files (default SEED_PRUNE)")
+	strict := fs.Bool("strict", false, "apply nothing if any record fails (default SEED_STRICT)")
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usagef("seed takes no arguments")
+	}
+
+	cfg, a, err := setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer a.Close()
+
+	opts := service.SeedOptions{
+		Strict: flagOr(fs, "strict", *strict, cfg.Seed.Strict),
+		Prune:  flagOr(fs, "prune", *prune, cfg.Seed.Prune),
+		DryRun: flagOr(fs, "dry-run", *dryRun, cfg.Seed.DryRun),
+	}
+
+	report, err := a.SeedSvc.Run(ctx, opts)
+	logSeedReport(ctx, report)
+	if err != nil {
+		return fmt.Errorf("seed: %w", err)
+	}
+	return nil
+}
+
+// flagOr returns the flag value if it was given on the command line, and fallback otherwise.
+func flagOr(fs *flag.FlagSet, name string, value, fallback bool) bool {
+	if isFlagSet(fs, name) {
+		return value
+	}
+	return fallback
+}
+
+// logSeedReport logs the outcome of a seed run as a "seed report" event with per-entity
+// counts, followed by an event for every pruned and failed record and unknown skill reference.
+// For a dry run this is the plan that would have been applied.
+func logSeedReport(ctx context.Context, report *service.SeedReport) {
+	if report == nil {
+		return
+	}
+	level := slog.LevelInfo
+	if report.HasProblems() {
+		level = slog.LevelWarn
+	}
+	slog.Log(ctx, level, "seed report",
+		"dry_run", report.DryRun,
+		"skills", report.Skills,
+		"experiences", report.Experiences,
+		"achievements", report.Achievements,
+		"projects", report.Projects,
+		"pruned", len(report.Pruned),
+		"failures", len(report.Failures),
+		"unknown_skills", len(report.UnknownSkills),
+	)
+	for _, p := range report.Pruned {
+		slog.InfoContext(ctx, "seed record pruned", "entity", p.Entity, "key", p.Key)
+	}
+	for _, f := range report.Failures {
+		slog.WarnContext(ctx, "seed record failed", "entity", f.Entity, "key", f.Key, "reason", f.Reason)
+	}
+	for _, u := range report.UnknownSkills {
+		slog.WarnContext(ctx, "seed unknown skill", "entity", u.Entity, "key", u.Key, "skill", u.Skill)
+	}
+}
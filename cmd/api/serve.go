@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/certs"
+	web "github.com/guillermoBallester/go-platform-cv/internal/adapter/handler/http"
+	"github.com/guillermoBallester/go-platform-cv/internal/app"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"github.com/guillermoBallester/go-platform-cv/sql"
+)
+
+// runServe serves HTTP until SIGINT or SIGTERM, applying pending migrations and seeding when
+// SEED_DATA is set once it listens. With --migrate=false, e.g. on replicas, migrations are
+// only checked.
+func runServe(ctx context.Context, args []string) error {
+	fs := newFlagSet("serve")
+	migrate := fs.Bool("migrate", true, "apply pending migrations before serving (default MIGRATE_ON_START)")
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usagef("serve takes no arguments")
+	}
+
+	cfg, a, err := setup(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Closes the app on early returns; after a shutdown this does nothing.
+	defer a.Close()
+
+	services := web.Services{
+		CV:         a.CvService,
+		Migrations: a.Migrator,
+		Health:     a.Health,
+		Metrics:    a.Metrics,
+		Audit:      a.Audit,
+		Snapshots:  a.Snapshots,
+		AdminCV:    a.AdminCV,
+	}
+	// A nil *CachedCVReader must not become a non-nil interface.
+	if a.Cache != nil {
+		services.Cache = a.Cache
+		go a.CacheListener.Run(ctx)
+	}
+	router := web.NewRouter(cfg, services)
+
+	var tlsConfig *tls.Config
+	wrapRedirect := func(h http.Handler) http.Handler { return h }
+	if cfg.TLS.Enabled() {
+		tlsConfig, wrapRedirect, err = certs.Setup(ctx, cfg.TLS)
+		if err != nil {
+			return fmt.Errorf("tls: %w", err)
+		}
+	}
+
+	// Listen before migrating and seeding, so liveness answers during a long migration.
+	// Readiness keeps traffic away, and every other route answers 503, until both have
+	// completed.
+	srv := web.NewServer(cfg, router, tlsConfig)
+	servers := []*web.Server{srv}
+	serveErr := make(chan error, 3)
+	go func() {
+		serveErr <- srv.Run()
+	}()
+
+	if cfg.TLS.RedirectAddr != "" {
+		redirectSrv := web.NewRedirectServer(cfg, cfg.TLS.RedirectAddr, wrapRedirect(web.RedirectToHTTPS(cfg.Server.Port)))
+		servers = append(servers, redirectSrv)
+		go func() {
+			serveErr <- redirectSrv.Run()
+		}()
+	}
+
+	if cfg.Metrics.Enabled && cfg.Metrics.Addr != "" {
+		metricsSrv := web.NewMetricsServer(cfg, a.Metrics.Handler())
+		servers = append(servers, metricsSrv)
+		go func() {
+			serveErr <- metricsSrv.Run()
+		}()
+	}
+
+	if err := prepareData(ctx, cfg, a, flagOr(fs, "migrate", *migrate, cfg.Migrations.OnStart)); err != nil {
+		_ = drainServers(servers)(context.Background())
+		return err
+	}
+	// Only now, so a reload seed never runs on an unmigrated schema or beside the startup seed.
+	if cfg.App.IsDevelopment() {
+		startHotReload(ctx, cfg, a, router)
+	}
+
+	select {
+	case err := <-serveErr:
+		// The server stopped on its own, e.g. because the port is taken.
+		return fmt.Errorf("server runtime error: %w", err)
+	case <-ctx.Done():
+	}
+	slog.Info("shutdown signal received")
+
+	// ctx is already cancelled, so the phases run on a fresh one; each server bounds its own
+	// drain with SERVER_SHUTDOWN_TIMEOUT.
+	err = shutdown(context.Background(),
+		shutdownPhase{name: "not_ready", run: func(context.Context) error {
+			a.Health.MarkShuttingDown()
+			return nil
+		}},
+		shutdownPhase{name: "pre_stop_delay", run: sleepPhase(cfg.Server.PreStopDelay)},
+		shutdownPhase{name: "drain", run: drainServers(servers)},
+		shutdownPhase{name: "close_db", run: func(context.Context) error {
+			a.Close()
+			return nil
+		}},
+	)
+	if err != nil {
+		return fmt.Errorf("server shutdown: %w", err)
+	}
+	return nil
+}
+
+// drainServers stops the servers from accepting connections and waits for their in-flight
+// requests, all at once so their timeouts do not add up.
+func drainServers(servers []*web.Server) func(context.Context) error {
+	return func(ctx context.Context) error {
+		errs := make([]error, len(servers))
+		var wg sync.WaitGroup
+		for i, s := range servers {
+			wg.Go(func() { errs[i] = s.Shutdown(ctx) })
+		}
+		wg.Wait()
+		return errors.Join(errs...)
+	}
+}
+
+// prepareData applies or checks migrations and runs the startup seed, then marks seeding as
+// completed for the readiness check.
+func prepareData(ctx context.Context, cfg *config.Config, a *app.App, migrate bool) error {
+	if migrate {
+		if err := migrateUp(ctx, a.Migrator); err != nil {
+			return fmt.Errorf("migrations: %w", err)
+		}
+	} else {
+		logMigrationState(ctx, a.Migrator)
+	}
+
+	if cfg.Seed.Enabled {
+		report, err := a.SeedSvc.Run(ctx, service.SeedOptions{
+			Strict: cfg.Seed.Strict,
+			Prune:  cfg.Seed.Prune,
+			DryRun: cfg.Seed.DryRun,
+		})
+		logSeedReport(ctx, report)
+		a.Metrics.ObserveSeedRun(report, err)
+		if err != nil {
+			if cfg.Seed.Strict {
+				return fmt.Errorf("seed: %w", err)
+			}
+			slog.WarnContext(ctx, "seed run failed; serving existing data", "error", err)
+		}
+	}
+
+	// A failed non-strict run still counts as completed: the instance serves what it has.
+	a.Health.MarkSeeded()
+	return nil
+}
+
+// logMigrationState warns when migrations are pending on an instance that does not apply them.
+// Serving carries on, as another instance or a deploy step is expected to migrate.
+func logMigrationState(ctx context.Context, migrator *sql.Migrator) {
+	state, err := migrator.State(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "migrations not applied on start; state unknown", "error", err)
+		return
+	}
+	if !state.UpToDate() {
+		slog.WarnContext(ctx, "migrations not applied on start; schema behind",
+			"current_version", state.Current,
+			"latest_version", state.Latest,
+			"pending", len(state.Pending),
+		)
+		return
+	}
+	slog.InfoContext(ctx, "migrations not applied on start; schema up to date", "current_version", state.Current)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// shutdownPhase is one step of a graceful shutdown.
+type shutdownPhase struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// shutdown runs the phases in order, logging how long each took. A failing phase does not
+// stop the ones after it; every error is returned, joined.
+func shutdown(ctx context.Context, phases ...shutdownPhase) error {
+	start := time.Now()
+	var errs []error
+	for _, p := range phases {
+		phaseStart := time.Now()
+		err := p.run(ctx)
+		elapsed := time.Since(phaseStart)
+		if err != nil {
+			slog.ErrorContext(ctx, "shutdown phase failed", "phase", p.name, "duration_ms", elapsed.Milliseconds(), "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", p.name, err))
+			continue
+		}
+		slog.InfoContext(ctx, "shutdown phase completed", "phase", p.name, "duration_ms", elapsed.Milliseconds())
+	}
+	slog.InfoContext(ctx, "shutdown completed", "duration_ms", time.Since(start).Milliseconds())
+	return errors.Join(errs...)
+}
+
+// sleepPhase waits for d, e.g. for load balancers to notice that readiness fails.
+func sleepPhase(d time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if d <= 0 {
+			return nil
+		}
+		t := time.NewTimer(d)
+		defer t.Stop()
+		select {
+		case <-t.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
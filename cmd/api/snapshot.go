@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+)
+
+// runSnapshot freezes the CV into a snapshot, lists the snapshots, prints one, or compares two.
+// Snapshots are named by version or by label.
+func runSnapshot(ctx context.Context, args []string) error {
+	fs := newFlagSet("snapshot")
+	label := fs.String("label", "", "label of the new snapshot, e.g. the company it is sent to")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		return usagef("snapshot needs an action")
+	}
+	action, rest := positional[0], positional[1:]
+
+	wantArgs := map[string]int{"create": 0, "list": 0, "show": 1, "diff": 2}
+	n, ok := wantArgs[action]
+	if !ok {
+		return usagef("unknown snapshot action %q", action)
+	}
+	if len(rest) != n {
+		return usagef("snapshot %s takes %d arguments", action, n)
+	}
+	if isFlagSet(fs, "label") && action != "create" {
+		return usagef("--label only applies to snapshot create")
+	}
+	if err := domain.ValidateSnapshotLabel(*label); err != nil {
+		return usageError{msg: err.Error()}
+	}
+
+	_, a, err := setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer a.Close()
+
+	snapshots := a.Snapshots
+	switch action {
+	case "create":
+		info, err := snapshots.Take(ctx, *label)
+		if err != nil {
+			return fmt.Errorf("snapshot: %w", err)
+		}
+		fmt.Printf("snapshot %d created\n", info.Version)
+		return nil
+	case "list":
+		infos, err := snapshots.List(ctx)
+		if err != nil {
+			return err
+		}
+		printSnapshots(infos)
+		return nil
+	case "show":
+		_, document, err := snapshots.Document(ctx, rest[0])
+		if err != nil {
+			return fmt.Errorf("snapshot %s: %w", rest[0], err)
+		}
+		var out bytes.Buffer
+		if err := json.Indent(&out, document, "", "  "); err != nil {
+			return err
+		}
+		out.WriteByte('\n')
+		_, err = out.WriteTo(os.Stdout)
+		return err
+	default:
+		changes, err := snapshots.Diff(ctx, rest[0], rest[1])
+		if err != nil {
+			return err
+		}
+		printCVChanges(changes)
+		return nil
+	}
+}
+
+func printSnapshots(infos []domain.SnapshotInfo) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tCREATED AT\tLABEL")
+	for _, s := range infos {
+		fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.CreatedAt.UTC().Format(time.RFC3339), s.Label)
+	}
+	_ = w.Flush()
+}
+
+func printCVChanges(changes []service.CVChange) {
+	if len(changes) == 0 {
+		fmt.Println("no changes")
+		return
+	}
+	for _, c := range changes {
+		fmt.Printf("%-8s %s %s\n", c.Change, c.Entity, c.Key)
+		for _, f := range c.Fields {
+			fmt.Printf("    %s: %q -> %q\n", f.Field, f.From, f.To)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	web "github.com/guillermoBallester/go-platform-cv/internal/adapter/handler/http"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/logging"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/seedsource"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/static"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/memory"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+)
+
+// runBuildStatic renders the CV from the seed files as a static site, without a database: the
+// home page, the JSON and Markdown exports, the assets and, given a base URL, a sitemap.
+func runBuildStatic(ctx context.Context, args []string) error {
+	fs := newFlagSet("build-static")
+	output := fs.String("output", "dist", "directory to write the site to")
+	source := fs.String("source", "", "seed source to render: embedded, a directory or a URL (default SEED_SOURCE)")
+	baseURL := fs.String("base-url", "", "absolute URL the site is published at, required for the sitemap")
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usagef("build-static takes no arguments")
+	}
+
+	// No database is involved, so the database settings are neither required nor validated.
+	cfg, err := config.Parse()
+	if err != nil {
+		return fmt.Errorf("config load: %w", err)
+	}
+	logging.Setup(cfg.App, os.Stderr)
+	if *source != "" {
+		cfg.Seed.Source = *source
+	}
+
+	src, err := seedsource.New(cfg.Seed)
+	if err != nil {
+		return fmt.Errorf("seed source: %w", err)
+	}
+
+	cv, report, err := service.LoadSeedCV(ctx, src)
+	if err != nil {
+		return err
+	}
+	logSeedReport(ctx, report)
+	if report.HasProblems() {
+		return fmt.Errorf("%w: %d invalid records, %d unknown skill references",
+			errInvalidSeeds, len(report.Failures), len(report.UnknownSkills))
+	}
+
+	pages, err := web.LoadPages(cfg.Theme, static.AssetsURL)
+	if err != nil {
+		return err
+	}
+
+	written, err := static.Build(ctx, service.NewCVService(memory.NewStore(cv.Public())), pages, static.Options{
+		OutputDir: *output,
+		BaseURL:   *baseURL,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("wrote %d files to %s\n", len(written), *output)
+	return nil
+}
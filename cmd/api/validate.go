@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/logging"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/seedsource"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+)
+
+// errInvalidSeeds is returned when validate-seeds finds problems, so CI fails the build.
+var errInvalidSeeds = errors.New("seed files have problems")
+
+// runValidateSeeds checks the seed files without a database and prints every problem found.
+func runValidateSeeds(ctx context.Context, args []string) error {
+	fs := newFlagSet("validate-seeds")
+	source := fs.String("source", "", "seed source to check: embedded, a directory or a URL (default SEED_SOURCE)")
+	rest, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return usagef("validate-seeds takes no arguments")
+	}
+
+	// No database is involved, so the database settings are neither required nor validated.
+	cfg, err := config.Parse()
+	if err != nil {
+		return fmt.Errorf("config load: %w", err)
+	}
+	logging.Setup(cfg.App, os.Stderr)
+	if *source != "" {
+		cfg.Seed.Source = *source
+	}
+
+	src, err := seedsource.New(cfg.Seed)
+	if err != nil {
+		return fmt.Errorf("seed source: %w", err)
+	}
+
+	report, err := service.ValidateSeeds(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range report.Failures {
+		fmt.Printf("%s %s: %s\n", f.Entity, f.Key, f.Reason)
+	}
+	for _, u := range report.UnknownSkills {
+		fmt.Printf("%s %s: unknown skill %q\n", u.Entity, u.Key, u.Skill)
+	}
+	if report.HasProblems() {
+		return fmt.Errorf("%w: %d invalid records, %d unknown skill references",
+			errInvalidSeeds, len(report.Failures), len(report.UnknownSkills))
+	}
+
+	fmt.Println("seed files are valid")
+	return nil
+}
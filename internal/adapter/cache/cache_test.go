@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaches(t *testing.T) {
+	server := miniredis.RunT(t)
+	redisCache, err := NewRedis("redis://"+server.Addr(), "test:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = redisCache.Close() })
+
+	caches := map[string]port.Cache{
+		"memory": NewMemory(),
+		"redis":  redisCache,
+	}
+
+	for name, c := range caches {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			_, ok, err := c.Get(ctx, "missing")
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			require.NoError(t, c.Set(ctx, "a", []byte("1"), time.Minute))
+			require.NoError(t, c.Set(ctx, "b", []byte("2"), time.Minute))
+			value, ok, err := c.Get(ctx, "a")
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, []byte("1"), value)
+
+			require.NoError(t, c.Delete(ctx, "a", "b", "never-set"))
+			_, ok, _ = c.Get(ctx, "a")
+			assert.False(t, ok)
+			_, ok, _ = c.Get(ctx, "b")
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestMemoryExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := NewMemory()
+	m.now = func() time.Time { return now }
+
+	require.NoError(t, m.Set(ctx, "k", []byte("v"), time.Minute))
+	_, ok, _ := m.Get(ctx, "k")
+	assert.True(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok, _ = m.Get(ctx, "k")
+	assert.False(t, ok)
+	assert.Empty(t, m.entries)
+}
+
+func TestRedisPrefix(t *testing.T) {
+	server := miniredis.RunT(t)
+	c, err := NewRedis("redis://"+server.Addr(), "gocv:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+
+	require.NoError(t, c.Set(context.Background(), "cv:skills", []byte("[]"), time.Minute))
+	assert.True(t, server.Exists("gocv:cv:skills"))
+	assert.Equal(t, time.Minute, server.TTL("gocv:cv:skills"))
+}
@@ -0,0 +1,67 @@
+// Package cache implements port.Cache in process memory and on a Redis-compatible server.
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+)
+
+type entry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// Memory is an in-process cache. Expired entries are dropped when they are next read.
+type Memory struct {
+	mu      sync.RWMutex
+	entries map[string]entry
+	now     func() time.Time
+}
+
+var _ port.Cache = (*Memory)(nil)
+
+// NewMemory creates an empty in-process cache.
+func NewMemory() *Memory {
+	return &Memory{entries: make(map[string]entry), now: time.Now}
+}
+
+// Get returns the value stored under key, and false if it is missing or expired.
+func (m *Memory) Get(_ context.Context, key string) ([]byte, bool, error) {
+	m.mu.RLock()
+	e, ok := m.entries[key]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, false, nil
+	}
+	if !m.now().Before(e.expiresAt) {
+		m.mu.Lock()
+		// Another writer may have replaced the entry since it was read.
+		if current, ok := m.entries[key]; ok && !m.now().Before(current.expiresAt) {
+			delete(m.entries, key)
+		}
+		m.mu.Unlock()
+		return nil, false, nil
+	}
+	return e.value, true, nil
+}
+
+// Set stores value under key for ttl.
+func (m *Memory) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	m.entries[key] = entry{value: value, expiresAt: m.now().Add(ttl)}
+	m.mu.Unlock()
+	return nil
+}
+
+// Delete removes the keys.
+func (m *Memory) Delete(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	for _, key := range keys {
+		delete(m.entries, key)
+	}
+	m.mu.Unlock()
+	return nil
+}
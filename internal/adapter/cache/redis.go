@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis is a cache on a Redis-compatible server, shared by every instance using the same
+// server and prefix.
+type Redis struct {
+	client *redis.Client
+	prefix string
+}
+
+var _ port.Cache = (*Redis)(nil)
+
+// NewRedis creates a cache on the server at url, e.g. redis://localhost:6379/0, storing every
+// key under prefix.
+func NewRedis(url, prefix string) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("parsing redis url: %w", err)
+	}
+	return &Redis{client: redis.NewClient(opts), prefix: prefix}, nil
+}
+
+// Get returns the value stored under key, and false if it is missing or expired.
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.client.Get(ctx, r.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set stores value under key for ttl.
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, r.prefix+key, value, ttl).Err()
+}
+
+// Delete removes the keys.
+func (r *Redis) Delete(ctx context.Context, keys ...string) error {
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = r.prefix + key
+	}
+	return r.client.Del(ctx, prefixed...).Err()
+}
+
+// Close closes the connections to the server.
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
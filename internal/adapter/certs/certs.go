@@ -0,0 +1,143 @@
+// Package certs provides the TLS certificates the server presents: from files on disk,
+// reloaded when they change, or obtained and renewed from an ACME CA.
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/watcher"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// reloadDebounce lets a certificate and its key both be replaced before they are reloaded.
+const reloadDebounce = time.Second
+
+// FileReloader serves the certificate of a key pair on disk, reloading it when the files
+// change, e.g. after a renewal by certbot.
+type FileReloader struct {
+	certFile string
+	keyFile  string
+	cert     atomic.Pointer[tls.Certificate]
+}
+
+// NewFileReloader loads the key pair, failing if it cannot be read.
+func NewFileReloader(certFile, keyFile string) (*FileReloader, error) {
+	r := &FileReloader{certFile: certFile, keyFile: keyFile}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the key pair again. If it cannot be read, the current certificate stays.
+func (r *FileReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("loading TLS key pair: %w", err)
+	}
+	r.cert.Store(&cert)
+	return nil
+}
+
+// GetCertificate is a tls.Config.GetCertificate returning the current certificate.
+func (r *FileReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.cert.Load(), nil
+}
+
+// Watch reloads the key pair whenever the directories holding it change, until ctx is
+// cancelled. Directories are watched, rather than the files, so that files replaced by a
+// rename or a symlink swap are picked up too.
+func (r *FileReloader) Watch(ctx context.Context) error {
+	dirs := []string{filepath.Dir(r.certFile)}
+	if dir := filepath.Dir(r.keyFile); !slices.Contains(dirs, dir) {
+		dirs = append(dirs, dir)
+	}
+	w, err := watcher.New(dirs, reloadDebounce, func(ctx context.Context, _ []string) {
+		if err := r.Reload(); err != nil {
+			slog.WarnContext(ctx, "TLS certificate not reloaded", "error", err)
+			return
+		}
+		slog.InfoContext(ctx, "TLS certificate reloaded", "cert_file", r.certFile)
+	})
+	if err != nil {
+		return err
+	}
+	go w.Run(ctx)
+	return nil
+}
+
+// NewACMEManager creates a manager obtaining and renewing certificates for cfg.ACMEDomains
+// from the ACME directory at cfg.ACMEDirectoryURL, agreeing to its terms of service.
+func NewACMEManager(cfg config.TLSConfig) (*autocert.Manager, error) {
+	httpClient := http.DefaultClient
+	if cfg.ACMECAFile != "" {
+		pem, err := os.ReadFile(cfg.ACMECAFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading ACME CA file: %w", err)
+		}
+		roots, err := x509.SystemCertPool()
+		if err != nil {
+			roots = x509.NewCertPool()
+		}
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, errors.New("ACME CA file holds no PEM certificates")
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+		httpClient = &http.Client{Transport: transport}
+	}
+
+	return &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(cfg.ACMECacheDir),
+		HostPolicy: autocert.HostWhitelist(cfg.ACMEDomains...),
+		Email:      cfg.ACMEEmail,
+		Client: &acme.Client{
+			DirectoryURL: cfg.ACMEDirectoryURL,
+			HTTPClient:   httpClient,
+		},
+	}, nil
+}
+
+// Setup returns the TLS configuration of the HTTPS server for cfg, which must have TLS
+// enabled, and a wrapper for the plain HTTP redirect handler that answers ACME HTTP-01
+// challenges when certificates come from ACME. File certificates are watched until ctx is
+// cancelled.
+func Setup(ctx context.Context, cfg config.TLSConfig) (*tls.Config, func(http.Handler) http.Handler, error) {
+	if cfg.UsesACME() {
+		m, err := NewACMEManager(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The manager's config also negotiates the tls-alpn-01 challenge protocol.
+		tlsConfig := m.TLSConfig()
+		tlsConfig.MinVersion = tls.VersionTLS12
+		return tlsConfig, m.HTTPHandler, nil
+	}
+
+	r, err := NewFileReloader(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := r.Watch(ctx); err != nil {
+		slog.WarnContext(ctx, "TLS certificate files not watched", "error", err)
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.GetCertificate,
+	}
+	return tlsConfig, func(h http.Handler) http.Handler { return h }, nil
+}
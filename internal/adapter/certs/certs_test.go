@@ -0,0 +1,246 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCA issues certificates for the tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+var serial atomic.Int64
+
+// issue signs a certificate for dnsNames and pub, returning it in DER.
+func (ca *testCA) issue(t *testing.T, pub any, dnsNames ...string) []byte {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial.Add(1) + 1),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, pub, ca.key)
+	require.NoError(t, err)
+	return der
+}
+
+// writeKeyPair writes a new certificate for name and its key as PEM files.
+func (ca *testCA) writeKeyPair(t *testing.T, certFile, keyFile, name string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.issue(t, &key.PublicKey, name)})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o644))
+}
+
+func leafName(t *testing.T, cert *tls.Certificate) string {
+	t.Helper()
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	return leaf.DNSNames[0]
+}
+
+func TestFileReloader(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	ca.writeKeyPair(t, certFile, keyFile, "old.example.com")
+
+	r, err := NewFileReloader(certFile, keyFile)
+	require.NoError(t, err)
+	cert, err := r.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "old.example.com", leafName(t, cert))
+
+	require.NoError(t, r.Watch(t.Context()))
+	ca.writeKeyPair(t, certFile, keyFile, "new.example.com")
+	assert.Eventually(t, func() bool {
+		cert, _ := r.GetCertificate(nil)
+		return leafName(t, cert) == "new.example.com"
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// A broken key pair leaves the current certificate in place.
+	require.NoError(t, os.WriteFile(keyFile, []byte("not a key"), 0o600))
+	assert.Error(t, r.Reload())
+	cert, err = r.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "new.example.com", leafName(t, cert))
+
+	_, err = NewFileReloader(filepath.Join(dir, "missing.crt"), keyFile)
+	assert.Error(t, err)
+}
+
+// acmeStandIn is a minimal ACME CA in the spirit of Pebble with PEBBLE_VA_ALWAYS_VALID: every
+// order is ready at once, so no challenge is served, and finalizing issues the certificate.
+// Request signatures are not verified.
+func acmeStandIn(t *testing.T, ca *testCA) *httptest.Server {
+	var srv *httptest.Server
+	var issued atomic.Pointer[[]byte]
+
+	payload := func(t *testing.T, r *http.Request, v any) {
+		var jws struct {
+			Payload string `json:"payload"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&jws))
+		if v == nil {
+			return
+		}
+		data, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, v))
+	}
+	reply := func(w http.ResponseWriter, status int, location string, body any) {
+		w.Header().Set("Content-Type", "application/json")
+		if location != "" {
+			w.Header().Set("Location", srv.URL+location)
+		}
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /dir", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK, "", map[string]string{
+			"newNonce":   srv.URL + "/nonce",
+			"newAccount": srv.URL + "/account",
+			"newOrder":   srv.URL + "/order",
+			"revokeCert": srv.URL + "/revoke",
+			"keyChange":  srv.URL + "/key-change",
+		})
+	})
+	mux.HandleFunc("/nonce", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("POST /account", func(w http.ResponseWriter, r *http.Request) {
+		payload(t, r, nil)
+		reply(w, http.StatusCreated, "/account/1", map[string]string{"status": "valid"})
+	})
+	mux.HandleFunc("POST /order", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Identifiers []map[string]string `json:"identifiers"`
+		}
+		payload(t, r, &req)
+		reply(w, http.StatusCreated, "/order/1", map[string]any{
+			"status":         "ready",
+			"identifiers":    req.Identifiers,
+			"authorizations": []string{},
+			"finalize":       srv.URL + "/finalize/1",
+		})
+	})
+	mux.HandleFunc("POST /finalize/1", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			CSR string `json:"csr"`
+		}
+		payload(t, r, &req)
+		der, err := base64.RawURLEncoding.DecodeString(req.CSR)
+		require.NoError(t, err)
+		csr, err := x509.ParseCertificateRequest(der)
+		require.NoError(t, err)
+		chain := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.issue(t, csr.PublicKey, csr.DNSNames...)})
+		chain = append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})...)
+		issued.Store(&chain)
+		reply(w, http.StatusOK, "/order/1", map[string]string{
+			"status":      "valid",
+			"certificate": srv.URL + "/cert/1",
+		})
+	})
+	mux.HandleFunc("POST /cert/1", func(w http.ResponseWriter, r *http.Request) {
+		payload(t, r, nil)
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		_, _ = w.Write(*issued.Load())
+	})
+
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Replay-Nonce", base64.RawURLEncoding.EncodeToString(big.NewInt(time.Now().UnixNano()).Bytes()))
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestACMEManager(t *testing.T) {
+	ca := newTestCA(t)
+	acme := acmeStandIn(t, ca)
+
+	// The stand-in's HTTPS certificate is trusted through the CA file, as a local Pebble's is.
+	caFile := filepath.Join(t.TempDir(), "pebble.minica.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: acme.Certificate().Raw}), 0o644))
+
+	cfg := config.TLSConfig{
+		ACMEDomains:      []string{"cv.example.com"},
+		ACMEDirectoryURL: acme.URL + "/dir",
+		ACMECAFile:       caFile,
+		ACMECacheDir:     t.TempDir(),
+	}
+	tlsConfig, wrapRedirect, err := Setup(t.Context(), cfg)
+	require.NoError(t, err)
+	assert.Contains(t, tlsConfig.NextProtos, "h2")
+	assert.NotNil(t, wrapRedirect(http.NotFoundHandler()))
+
+	cert, err := tlsConfig.GetCertificate(&tls.ClientHelloInfo{ServerName: "cv.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "cv.example.com", leafName(t, cert))
+
+	_, err = tlsConfig.GetCertificate(&tls.ClientHelloInfo{ServerName: "other.example.com"})
+	assert.Error(t, err, "only the configured domains get certificates")
+
+	// The certificate is cached on disk, so a restart does not ask the CA again.
+	entries, err := os.ReadDir(cfg.ACMECacheDir)
+	require.NoError(t, err)
+	assert.NotEmpty(t, entries)
+}
+
+func TestNewACMEManagerBadCAFile(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("no certificates"), 0o644))
+
+	_, err := NewACMEManager(config.TLSConfig{ACMEDomains: []string{"cv.example.com"}, ACMECAFile: caFile})
+	assert.Error(t, err)
+}
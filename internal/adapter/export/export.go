@@ -0,0 +1,169 @@
+// Package export renders a CV as a standalone document: JSON, Markdown, LaTeX or PDF.
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// Supported export formats.
+const (
+	FormatJSON     = "json"
+	FormatMarkdown = "md"
+	FormatLaTeX    = "tex"
+	FormatPDF      = "pdf"
+)
+
+// Formats lists the supported export formats.
+var Formats = []string{FormatPDF, FormatMarkdown, FormatJSON, FormatLaTeX}
+
+var (
+	// ErrUnknownFormat is returned for a format that is not in Formats.
+	ErrUnknownFormat = errors.New("unknown export format")
+	// ErrUnsupportedText is returned when the CV has characters a format cannot show.
+	ErrUnsupportedText = errors.New("unsupported characters")
+)
+
+// title is the heading of every exported document.
+const title = "Curriculum Vitae"
+
+// Write renders cv to w in the given format.
+func Write(w io.Writer, format string, cv domain.CV) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, cv)
+	case FormatMarkdown:
+		return writeMarkdown(w, cv)
+	case FormatLaTeX:
+		return writeLaTeX(w, cv)
+	case FormatPDF:
+		return writePDF(w, cv)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+// ContentType returns the MIME type of a format, or "" if the format is unknown.
+func ContentType(format string) string {
+	switch format {
+	case FormatJSON:
+		return "application/json"
+	case FormatMarkdown:
+		return "text/markdown; charset=utf-8"
+	case FormatLaTeX:
+		return "application/x-tex"
+	case FormatPDF:
+		return "application/pdf"
+	default:
+		return ""
+	}
+}
+
+// blockKind identifies the role of a block in the document outline.
+type blockKind int
+
+const (
+	blockSection blockKind = iota // section heading, e.g. "Experience"
+	blockEntry                    // entry heading, e.g. a job title and company
+	blockMeta                     // entry dates and location
+	blockText                     // paragraph
+	blockLabeled                  // labelled list, e.g. "Skills: Go, Docker"
+)
+
+// block is a unit of the document outline shared by the text-based formats.
+type block struct {
+	kind  blockKind
+	label string
+	text  string
+}
+
+// outline lays out the CV as a flat list of blocks: experience, projects, achievements and
+// skills grouped by category. Empty sections and fields are left out.
+func outline(cv domain.CV) []block {
+	var blocks []block
+	add := func(kind blockKind, label, text string) {
+		if text != "" {
+			blocks = append(blocks, block{kind: kind, label: label, text: text})
+		}
+	}
+
+	if len(cv.Experiences) > 0 {
+		add(blockSection, "", "Experience")
+	}
+	for _, e := range cv.Experiences {
+		add(blockEntry, "", e.JobTitle+" — "+e.CompanyName)
+		meta := period(&e.StartDate, e.EndDate)
+		if e.Location != "" {
+			meta += " · " + e.Location
+		}
+		add(blockMeta, "", meta)
+		add(blockText, "", e.Description)
+		add(blockText, "", e.Highlights)
+		add(blockLabeled, "Skills", strings.Join(skillNames(e.Skills), ", "))
+	}
+
+	if len(cv.Projects) > 0 {
+		add(blockSection, "", "Projects")
+	}
+	for _, p := range cv.Projects {
+		add(blockEntry, "", p.Name)
+		add(blockMeta, "", period(p.StartDate, p.EndDate))
+		add(blockText, "", p.Description)
+		add(blockLabeled, "Skills", strings.Join(skillNames(p.Skills), ", "))
+	}
+
+	if len(cv.Achievements) > 0 {
+		add(blockSection, "", "Achievements")
+	}
+	for _, a := range cv.Achievements {
+		add(blockEntry, "", a.Title)
+		if a.Date != nil {
+			add(blockMeta, "", a.Date.Format("Jan 2006"))
+		}
+		add(blockText, "", a.Description)
+		add(blockLabeled, "Skills", strings.Join(skillNames(a.Skills), ", "))
+	}
+
+	if len(cv.Skills) > 0 {
+		add(blockSection, "", "Skills")
+	}
+	var categories []string
+	byCategory := make(map[string][]domain.Skill)
+	for _, s := range cv.Skills {
+		if _, ok := byCategory[s.Category]; !ok {
+			categories = append(categories, s.Category)
+		}
+		byCategory[s.Category] = append(byCategory[s.Category], s)
+	}
+	for _, category := range categories {
+		add(blockLabeled, category, strings.Join(skillNames(byCategory[category]), ", "))
+	}
+
+	return blocks
+}
+
+// period formats a date range such as "Mar 2022 – Present". It returns "" without a start date.
+func period(start, end *time.Time) string {
+	if start == nil {
+		return ""
+	}
+	to := "Present"
+	if end != nil {
+		to = end.Format("Jan 2006")
+	}
+	return start.Format("Jan 2006") + " – " + to
+}
+
+// skillNames returns the names of skills, in order.
+func skillNames(skills []domain.Skill) []string {
+	names := make([]string, len(skills))
+	for i, skill := range skills {
+		names[i] = skill.Name
+	}
+	return names
+}
@@ -0,0 +1,152 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCV() domain.CV {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	goSkill := domain.Skill{Name: "Go", Category: "Language", Proficiency: 90}
+	return domain.CV{
+		Skills: []domain.Skill{goSkill, {Name: "Postgres", Category: "Database", Proficiency: 80}},
+		Experiences: []domain.Experience{{
+			CompanyName: "Acme & Co",
+			JobTitle:    "Engineer",
+			Location:    "Oslo",
+			StartDate:   start,
+			Description: "Cut costs by 50% (roughly)",
+			Skills:      []domain.Skill{goSkill},
+		}},
+		Projects: []domain.Project{{Name: "CV", Description: "This site", StartDate: &start}},
+	}
+}
+
+func TestWriteFormats(t *testing.T) {
+	tests := []struct {
+		format   string
+		contains []string
+	}{
+		{FormatMarkdown, []string{"## Experience", "### Engineer — Acme & Co", "*Mar 2022 – Present · Oslo*", "**Skills:** Go", "**Database:** Postgres"}},
+		{FormatLaTeX, []string{`\subsubsection*{Engineer — Acme \& Co}`, `Cut costs by 50\% (roughly)`, `\end{document}`}},
+		{FormatPDF, []string{"%PDF-1.4", "(Cut costs by 50% \\(roughly\\)) Tj", "/Count 1", "%%EOF"}},
+		{FormatJSON, []string{`"company_name": "Acme & Co"`, `"start_date": "2022-03-01"`, `"end_date": null`, `"skills": [`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, tt.format, testCV()))
+			for _, want := range tt.contains {
+				assert.Contains(t, buf.String(), want)
+			}
+		})
+	}
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	err := Write(&bytes.Buffer{}, "docx", testCV())
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestJSONIsSeedShaped(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatJSON, testCV()))
+
+	var doc map[string][]map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, []any{"Go"}, doc["experiences"][0]["skills"])
+	assert.Equal(t, "", doc["skills"][0]["logo_url"])
+}
+
+func TestJSONVisibility(t *testing.T) {
+	deleted := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	cv := testCV()
+	cv.Skills[0].Visibility = domain.VisibilityPublic
+	cv.Projects[0].Visibility = domain.VisibilityPrivate
+	cv.Projects[0].DeletedAt = &deleted
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatJSON, cv))
+
+	var doc map[string][]map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.NotContains(t, doc["skills"][0], "visibility")
+	assert.NotContains(t, doc["skills"][0], "deleted_at")
+	assert.Equal(t, "private", doc["projects"][0]["visibility"])
+	assert.Equal(t, "2026-10-01T12:00:00Z", doc["projects"][0]["deleted_at"])
+}
+
+func TestPDFCrossReference(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatPDF, testCV()))
+	pdf := buf.Bytes()
+
+	// Every xref entry must point at the start of its object.
+	entries := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllSubmatch(pdf, -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(pdf[offset:], []byte(strconv.Itoa(i+1)+" 0 obj")), "object %d", i+1)
+	}
+}
+
+func TestPDFPagination(t *testing.T) {
+	cv := testCV()
+	for range 80 {
+		cv.Projects = append(cv.Projects, domain.Project{Name: "Project", Description: "A project description"})
+	}
+
+	pages, err := pdfPages(outline(cv))
+	require.NoError(t, err)
+	assert.Greater(t, len(pages), 1)
+}
+
+func TestWrap(t *testing.T) {
+	lines := wrap("one two three", 200)
+	assert.Equal(t, []string{"one", "two", "three"}, lines)
+	assert.Equal(t, []string{""}, wrap("", 10))
+}
+
+func TestPDFText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+		err      error
+	}{
+		{"ascii with escapes", `Go (1.25) \ C`, `Go \(1.25\) \\ C`, nil},
+		{"latin-1", "Zürich", "Z\xfcrich", nil},
+		{"winansi extras", "Škoda – “Œuvre”", "\x8akoda \x96 \x93\x8cuvre\x94", nil},
+		{"outside winansi", "Łódź", "", ErrUnsupportedText},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pdfText(tt.text)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestPDFRejectsUnsupportedText(t *testing.T) {
+	cv := testCV()
+	cv.Experiences[0].CompanyName = "Łódź Labs"
+	err := Write(&bytes.Buffer{}, FormatPDF, cv)
+	assert.ErrorIs(t, err, ErrUnsupportedText)
+	assert.ErrorContains(t, err, "Ł")
+}
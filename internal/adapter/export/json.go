@@ -0,0 +1,149 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// The JSON export uses the seed file structure, so an export can be fed back to the seeder.
+// Visibility is only written for records that are not public. Deleted records, which only the
+// admin API sees, carry deleted_at; the seed schemas reject it, so they are never restored by
+// accident.
+
+type jsonCV struct {
+	Skills       []jsonSkill       `json:"skills"`
+	Experiences  []jsonExperience  `json:"experiences"`
+	Achievements []jsonAchievement `json:"achievements"`
+	Projects     []jsonProject     `json:"projects"`
+}
+
+type jsonSkill struct {
+	Name        string  `json:"name"`
+	Category    string  `json:"category"`
+	Proficiency int32   `json:"proficiency"`
+	LogoPath    string  `json:"logo_url"`
+	Visibility  string  `json:"visibility,omitempty"`
+	DeletedAt   *string `json:"deleted_at,omitempty"`
+}
+
+type jsonExperience struct {
+	CompanyName string   `json:"company_name"`
+	JobTitle    string   `json:"job_title"`
+	Location    string   `json:"location"`
+	StartDate   string   `json:"start_date"`
+	EndDate     *string  `json:"end_date"`
+	Description string   `json:"description"`
+	Highlights  string   `json:"highlights"`
+	Skills      []string `json:"skills"`
+	Visibility  string   `json:"visibility,omitempty"`
+	DeletedAt   *string  `json:"deleted_at,omitempty"`
+}
+
+type jsonAchievement struct {
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	Date         *string  `json:"date"`
+	ExperienceID *int32   `json:"experience_id"`
+	ProjectID    *int32   `json:"project_id"`
+	Skills       []string `json:"skills"`
+	Visibility   string   `json:"visibility,omitempty"`
+	DeletedAt    *string  `json:"deleted_at,omitempty"`
+}
+
+type jsonProject struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	StartDate   *string  `json:"start_date"`
+	EndDate     *string  `json:"end_date"`
+	Skills      []string `json:"skills"`
+	Visibility  string   `json:"visibility,omitempty"`
+	DeletedAt   *string  `json:"deleted_at,omitempty"`
+}
+
+func writeJSON(w io.Writer, cv domain.CV) error {
+	doc := jsonCV{
+		Skills:       make([]jsonSkill, len(cv.Skills)),
+		Experiences:  make([]jsonExperience, len(cv.Experiences)),
+		Achievements: make([]jsonAchievement, len(cv.Achievements)),
+		Projects:     make([]jsonProject, len(cv.Projects)),
+	}
+	for i, s := range cv.Skills {
+		doc.Skills[i] = jsonSkill{
+			Name:        s.Name,
+			Category:    s.Category,
+			Proficiency: s.Proficiency,
+			LogoPath:    s.LogoPath,
+			Visibility:  jsonVisibility(s.Visibility),
+			DeletedAt:   jsonTime(s.DeletedAt),
+		}
+	}
+	for i, e := range cv.Experiences {
+		doc.Experiences[i] = jsonExperience{
+			CompanyName: e.CompanyName,
+			JobTitle:    e.JobTitle,
+			Location:    e.Location,
+			StartDate:   e.StartDate.Format(time.DateOnly),
+			EndDate:     jsonDate(e.EndDate),
+			Description: e.Description,
+			Highlights:  e.Highlights,
+			Skills:      skillNames(e.Skills),
+			Visibility:  jsonVisibility(e.Visibility),
+			DeletedAt:   jsonTime(e.DeletedAt),
+		}
+	}
+	for i, a := range cv.Achievements {
+		doc.Achievements[i] = jsonAchievement{
+			Title:        a.Title,
+			Description:  a.Description,
+			Date:         jsonDate(a.Date),
+			ExperienceID: a.ExperienceID,
+			ProjectID:    a.ProjectID,
+			Skills:       skillNames(a.Skills),
+			Visibility:   jsonVisibility(a.Visibility),
+			DeletedAt:    jsonTime(a.DeletedAt),
+		}
+	}
+	for i, p := range cv.Projects {
+		doc.Projects[i] = jsonProject{
+			Name:        p.Name,
+			Description: p.Description,
+			StartDate:   jsonDate(p.StartDate),
+			EndDate:     jsonDate(p.EndDate),
+			Skills:      skillNames(p.Skills),
+			Visibility:  jsonVisibility(p.Visibility),
+			DeletedAt:   jsonTime(p.DeletedAt),
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(doc)
+}
+
+func jsonDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.DateOnly)
+	return &s
+}
+
+func jsonTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
+
+// jsonVisibility leaves out the default, so public exports read like the seed files.
+func jsonVisibility(v domain.Visibility) string {
+	if v == domain.VisibilityPublic {
+		return ""
+	}
+	return string(v)
+}
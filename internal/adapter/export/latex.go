@@ -0,0 +1,53 @@
+package export
+
+import (
+	"io"
+	"strings"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// latexEscaper escapes the characters LaTeX treats specially in running text.
+var latexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+)
+
+func writeLaTeX(w io.Writer, cv domain.CV) error {
+	var b strings.Builder
+	b.WriteString(`\documentclass[11pt,a4paper]{article}
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage[margin=2cm]{geometry}
+\setlength{\parindent}{0pt}
+\setlength{\parskip}{0.5em}
+\begin{document}
+`)
+	b.WriteString(`\section*{` + latexEscaper.Replace(title) + "}\n")
+	for _, blk := range outline(cv) {
+		text := latexEscaper.Replace(blk.text)
+		switch blk.kind {
+		case blockSection:
+			b.WriteString(`\subsection*{` + text + "}\n")
+		case blockEntry:
+			b.WriteString(`\subsubsection*{` + text + "}\n")
+		case blockMeta:
+			b.WriteString(`\textit{` + text + "}\n\n")
+		case blockText:
+			b.WriteString(text + "\n\n")
+		case blockLabeled:
+			b.WriteString(`\textbf{` + latexEscaper.Replace(blk.label) + ":} " + text + "\n\n")
+		}
+	}
+	b.WriteString(`\end{document}` + "\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
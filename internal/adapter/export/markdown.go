@@ -0,0 +1,29 @@
+package export
+
+import (
+	"io"
+	"strings"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+func writeMarkdown(w io.Writer, cv domain.CV) error {
+	var b strings.Builder
+	b.WriteString("# " + title + "\n")
+	for _, blk := range outline(cv) {
+		switch blk.kind {
+		case blockSection:
+			b.WriteString("\n## " + blk.text + "\n")
+		case blockEntry:
+			b.WriteString("\n### " + blk.text + "\n")
+		case blockMeta:
+			b.WriteString("\n*" + blk.text + "*\n")
+		case blockText:
+			b.WriteString("\n" + blk.text + "\n")
+		case blockLabeled:
+			b.WriteString("\n**" + blk.label + ":** " + blk.text + "\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
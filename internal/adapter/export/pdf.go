@@ -0,0 +1,168 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// A4 page geometry, in points.
+const (
+	pageWidth  = 595.0
+	pageHeight = 842.0
+	pageMargin = 56.0
+)
+
+// writePDF renders the outline as a plain PDF 1.4 document using the standard Helvetica fonts,
+// so no font files need to be embedded. These only cover WinAnsiEncoding, roughly Western
+// European text; any other character fails the export with ErrUnsupportedText.
+func writePDF(w io.Writer, cv domain.CV) error {
+	pages, err := pdfPages(outline(cv))
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Objects 1-4 are the catalog, page tree and fonts; each page then takes two objects,
+	// the page itself followed by its content stream.
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, content := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %g %g] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// pdfPages lays out the blocks as wrapped lines and returns the content stream of each page.
+func pdfPages(blocks []block) ([]string, error) {
+	var pages []string
+	var page strings.Builder
+	y := pageHeight - pageMargin
+
+	var err error
+	line := func(font string, size, gap float64, text string) {
+		if err != nil {
+			return
+		}
+		for i, wrapped := range wrap(text, size) {
+			encoded, encErr := pdfText(wrapped)
+			if encErr != nil {
+				err = encErr
+				return
+			}
+			leading := size * 1.35
+			if i == 0 {
+				leading += gap
+			}
+			if y-leading < pageMargin {
+				pages = append(pages, page.String())
+				page.Reset()
+				y = pageHeight - pageMargin
+				leading = size * 1.35
+			}
+			y -= leading
+			fmt.Fprintf(&page, "BT /%s %g Tf %g %.2f Td (%s) Tj ET\n", font, size, pageMargin, y, encoded)
+		}
+	}
+
+	line("F2", 20, 0, title)
+	for _, blk := range blocks {
+		switch blk.kind {
+		case blockSection:
+			line("F2", 14, 14, blk.text)
+		case blockEntry:
+			line("F2", 11, 8, blk.text)
+		case blockMeta:
+			line("F1", 9, 0, blk.text)
+		case blockText:
+			line("F1", 10, 4, blk.text)
+		case blockLabeled:
+			line("F1", 10, 4, blk.label+": "+blk.text)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append(pages, page.String()), nil
+}
+
+// wrap splits text into lines that fit the page width at the given font size, breaking at spaces.
+// Helvetica glyphs average about half an em, which is close enough for prose.
+func wrap(text string, size float64) []string {
+	limit := int((pageWidth - 2*pageMargin) / (size * 0.5))
+
+	var lines []string
+	var current []rune
+	for _, word := range strings.Fields(text) {
+		w := []rune(word)
+		if len(current) > 0 && len(current)+1+len(w) > limit {
+			lines = append(lines, string(current))
+			current = current[:0]
+		}
+		if len(current) > 0 {
+			current = append(current, ' ')
+		}
+		current = append(current, w...)
+	}
+	if len(current) > 0 || len(lines) == 0 {
+		lines = append(lines, string(current))
+	}
+	return lines
+}
+
+// winAnsi maps the characters of WinAnsiEncoding outside Latin-1 to their bytes.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88,
+	'‰': 0x89, 'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c, 'Ž': 0x8e, '‘': 0x91, '’': 0x92, '“': 0x93,
+	'”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b,
+	'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// pdfText encodes text as the contents of a PDF literal string in WinAnsiEncoding. It fails
+// on characters the standard fonts cannot show rather than printing them wrong.
+func pdfText(text string) (string, error) {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f, r >= 0xa0 && r <= 0xff:
+			b.WriteByte(byte(r))
+		case winAnsi[r] != 0:
+			b.WriteByte(winAnsi[r])
+		default:
+			return "", fmt.Errorf("%w: the PDF export cannot show %q in %q", ErrUnsupportedText, r, text)
+		}
+	}
+	return b.String(), nil
+}
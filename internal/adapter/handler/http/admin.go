@@ -0,0 +1,38 @@
+package http
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requireAdmin only lets requests through that carry the admin token as a bearer token.
+// Without a configured token the admin API does not exist, so every request gets a 404.
+func requireAdmin(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			abortWithError(c, http.StatusNotFound, "", nil)
+			return
+		}
+
+		given, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			c.Header("WWW-Authenticate", `Bearer realm="admin"`)
+			abortWithError(c, http.StatusUnauthorized, "", nil)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// privateResponses keeps the responses out of shared caches, overriding the configured
+// Cache-Control.
+func privateResponses() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "private, no-cache")
+		c.Next()
+	}
+}
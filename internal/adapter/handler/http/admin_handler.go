@@ -0,0 +1,149 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/export"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"github.com/guillermoBallester/go-platform-cv/sql"
+)
+
+// Page sizes of the audit log.
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 200
+)
+
+// MigrationStateReader reports the schema migration state of the database.
+type MigrationStateReader interface {
+	State(ctx context.Context) (sql.MigrationState, error)
+}
+
+// CacheStatsReader reports the hit, miss and invalidation counts of the CV cache.
+type CacheStatsReader interface {
+	Stats() service.CacheStats
+}
+
+// HandleMigrationStatus reports the current and latest schema versions and the pending migrations.
+func (r *Router) HandleMigrationStatus(c *gin.Context) {
+	state, err := r.migrations.State(c.Request.Context())
+	if err != nil {
+		abortWithError(c, http.StatusServiceUnavailable, "migration state unavailable", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"current_version": state.Current,
+		"latest_version":  state.Latest,
+		"up_to_date":      state.UpToDate(),
+		"pending":         state.Pending,
+	})
+}
+
+// HandleCacheStats reports the CV cache statistics of this instance.
+func (r *Router) HandleCacheStats(c *gin.Context) {
+	c.JSON(http.StatusOK, r.cache.Stats())
+}
+
+// HandleAdminCV writes the whole CV as seed-shaped JSON, with the visibility of every record
+// and the deletion time of deleted ones.
+func (r *Router) HandleAdminCV(c *gin.Context) {
+	cv, err := r.adminCV.GetCV(c.Request.Context())
+	if err != nil {
+		abortWithError(c, http.StatusInternalServerError, "", fmt.Errorf("loading CV: %w", err))
+		return
+	}
+
+	var doc bytes.Buffer
+	if err := export.Write(&doc, export.FormatJSON, cv); err != nil {
+		abortWithError(c, http.StatusInternalServerError, "", fmt.Errorf("exporting CV: %w", err))
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, export.ContentType(export.FormatJSON), doc.Bytes())
+}
+
+// auditPage is a page of the audit log.
+type auditPage struct {
+	Events []domain.AuditEvent `json:"events"`
+	// NextCursor continues with the next page; it is empty on the last one.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// HandleAuditEvents lists the audit log, newest first, a page at a time. It is filtered by the
+// entity, since and until (RFC 3339) query parameters; cursor, from next_cursor of the previous
+// page, continues after it.
+func (r *Router) HandleAuditEvents(c *gin.Context) {
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		abortWithError(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	// One more event than asked for tells whether there is a next page.
+	want := filter.Limit
+	filter.Limit++
+	events, err := r.audit.ListAuditEvents(c.Request.Context(), filter)
+	if err != nil {
+		abortWithError(c, http.StatusServiceUnavailable, "audit log unavailable", err)
+		return
+	}
+
+	page := auditPage{Events: events}
+	if len(events) > want {
+		page.Events = events[:want]
+		page.NextCursor = strconv.FormatInt(page.Events[want-1].ID, 10)
+	}
+	if page.Events == nil {
+		page.Events = []domain.AuditEvent{}
+	}
+	c.JSON(http.StatusOK, page)
+}
+
+// parseAuditFilter reads the audit log filter from the query string.
+func parseAuditFilter(c *gin.Context) (domain.AuditFilter, error) {
+	filter := domain.AuditFilter{Entity: c.Query("entity"), Limit: defaultAuditLimit}
+	if filter.Entity != "" && !slices.Contains(domain.AuditEntities, filter.Entity) {
+		return filter, fmt.Errorf("unknown entity %q: use one of %v", filter.Entity, domain.AuditEntities)
+	}
+
+	for name, t := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		v := c.Query(name)
+		if v == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, fmt.Errorf("invalid %s %q: use an RFC 3339 time", name, v)
+		}
+		*t = parsed
+	}
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return filter, errors.New("since must be before until")
+	}
+
+	if v := c.Query("cursor"); v != "" {
+		cursor, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || cursor < 1 {
+			return filter, fmt.Errorf("invalid cursor %q", v)
+		}
+		filter.Before = cursor
+	}
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxAuditLimit {
+			return filter, fmt.Errorf("invalid limit %q: use 1 to %d", v, maxAuditLimit)
+		}
+		filter.Limit = limit
+	}
+	return filter, nil
+}
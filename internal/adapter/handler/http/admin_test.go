@@ -0,0 +1,153 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/memory"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		token         string
+		authorization string
+		expected      int
+	}{
+		{"valid token", "secret", "Bearer secret", http.StatusOK},
+		{"wrong token", "secret", "Bearer guess", http.StatusUnauthorized},
+		{"missing header", "secret", "", http.StatusUnauthorized},
+		{"basic auth", "secret", "Basic c2VjcmV0", http.StatusUnauthorized},
+		{"admin disabled", "", "Bearer ", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gin.New()
+			g.GET("/admin", requireAdmin(tt.token), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+// fakeAuditLog returns events numbered down from newest, and records the filter it got.
+type fakeAuditLog struct {
+	newest int64
+	filter domain.AuditFilter
+}
+
+func (f *fakeAuditLog) ListAuditEvents(_ context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	f.filter = filter
+	start := f.newest
+	if filter.Before > 0 {
+		start = filter.Before - 1
+	}
+	var events []domain.AuditEvent
+	for id := start; id > 0 && len(events) < filter.Limit; id-- {
+		events = append(events, domain.AuditEvent{ID: id, Actor: domain.ActorSeed, Entity: domain.EntitySkill, Action: domain.AuditCreate})
+	}
+	return events, nil
+}
+
+func TestHandleAuditEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		query    string
+		expected int
+		ids      []int64
+		next     string
+		filter   domain.AuditFilter
+	}{
+		{"first page", "?limit=2", http.StatusOK, []int64{5, 4}, "4", domain.AuditFilter{Limit: 3}},
+		{"next page", "?limit=2&cursor=4", http.StatusOK, []int64{3, 2}, "2", domain.AuditFilter{Before: 4, Limit: 3}},
+		{"last page", "?limit=2&cursor=2", http.StatusOK, []int64{1}, "", domain.AuditFilter{Before: 2, Limit: 3}},
+		{"filters", "?entity=skill&since=2026-10-01T00:00:00Z", http.StatusOK, []int64{5, 4, 3, 2, 1}, "",
+			domain.AuditFilter{Entity: "skill", Since: since, Limit: defaultAuditLimit + 1}},
+		{"link entity", "?entity=experience_skill", http.StatusOK, []int64{5, 4, 3, 2, 1}, "",
+			domain.AuditFilter{Entity: domain.EntityExperienceSkill, Limit: defaultAuditLimit + 1}},
+		{"unknown entity", "?entity=user", http.StatusBadRequest, nil, "", domain.AuditFilter{}},
+		{"bad time", "?until=yesterday", http.StatusBadRequest, nil, "", domain.AuditFilter{}},
+		{"empty range", "?since=2026-10-02T00:00:00Z&until=2026-10-01T00:00:00Z", http.StatusBadRequest, nil, "", domain.AuditFilter{}},
+		{"limit too large", "?limit=1000", http.StatusBadRequest, nil, "", domain.AuditFilter{}},
+		{"bad cursor", "?cursor=abc", http.StatusBadRequest, nil, "", domain.AuditFilter{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			audit := &fakeAuditLog{newest: 5}
+			r := &Router{audit: audit}
+			g := gin.New()
+			g.GET("/api/admin/audit", r.HandleAuditEvents)
+
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/admin/audit"+tt.query, nil))
+
+			require.Equal(t, tt.expected, w.Code)
+			if tt.expected != http.StatusOK {
+				return
+			}
+			var page auditPage
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+			ids := make([]int64, len(page.Events))
+			for i, e := range page.Events {
+				ids[i] = e.ID
+			}
+			assert.Equal(t, tt.ids, ids)
+			assert.Equal(t, tt.next, page.NextCursor)
+			assert.Equal(t, tt.filter, audit.filter)
+		})
+	}
+}
+
+func TestHandleAdminCV(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	deleted := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	cv := domain.CV{Projects: []domain.Project{
+		{Name: "Shown", Visibility: domain.VisibilityPublic},
+		{Name: "Draft", Visibility: domain.VisibilityPrivate},
+		{Name: "Gone", Visibility: domain.VisibilityPublic, DeletedAt: &deleted},
+	}}
+	r := &Router{adminCV: service.NewCVService(memory.NewStore(cv))}
+	g := gin.New()
+	g.GET("/api/admin/cv", r.HandleAdminCV)
+
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/admin/cv", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	var doc struct {
+		Projects []struct {
+			Name       string `json:"name"`
+			Visibility string `json:"visibility"`
+			DeletedAt  string `json:"deleted_at"`
+		} `json:"projects"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	require.Len(t, doc.Projects, 3)
+	assert.Equal(t, "", doc.Projects[0].Visibility)
+	assert.Equal(t, "private", doc.Projects[1].Visibility)
+	assert.Equal(t, "2026-10-01T12:00:00Z", doc.Projects[2].DeletedAt)
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AssetsDir is the directory of a theme directory whose assets override the built-in ones.
+const AssetsDir = "assets"
+
+// assetsPrefix is the URL path the assets are served under.
+const assetsPrefix = "/assets/"
+
+// immutableCacheControl lets clients keep a content-hashed asset forever: a new version
+// of the file gets a new URL.
+const immutableCacheControl = "public, max-age=31536000, immutable"
+
+// assetManifest maps asset names to content-hashed names, e.g. logos/go.svg to
+// logos/go.1a2b3c4d5e.svg, and back.
+type assetManifest struct {
+	fsys     fs.FS
+	baseURL  string
+	hashed   map[string]string
+	original map[string]string
+}
+
+// newAssetManifest hashes every file of fsys, to be linked under baseURL.
+func newAssetManifest(fsys fs.FS, baseURL string) (*assetManifest, error) {
+	m := &assetManifest{
+		fsys:     fsys,
+		baseURL:  baseURL,
+		hashed:   make(map[string]string),
+		original: make(map[string]string),
+	}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(content)
+		ext := path.Ext(name)
+		hashed := strings.TrimSuffix(name, ext) + "." + hex.EncodeToString(sum[:5]) + ext
+		m.hashed[name] = hashed
+		m.original[hashed] = name
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("hashing assets: %w", err)
+	}
+	return m, nil
+}
+
+// URL returns the content-hashed URL of an asset, given as "logos/go.svg" or, as seed files
+// link them, "/assets/logos/go.svg". Anything else, such as an external URL, is returned
+// unchanged.
+func (m *assetManifest) URL(ref string) string {
+	name := strings.TrimPrefix(ref, assetsPrefix)
+	if hashed, ok := m.hashed[name]; ok {
+		return m.baseURL + hashed
+	}
+	return ref
+}
+
+// HandleAsset serves a static asset. Content-hashed URLs are cached as immutable; plain
+// names, still used by older links, are revalidated through Last-Modified.
+func (r *Router) HandleAsset(c *gin.Context) {
+	assets := r.pages.Load().assets
+	name := strings.TrimPrefix(c.Param("filepath"), "/")
+	if original, ok := assets.original[name]; ok {
+		c.Header("Cache-Control", immutableCacheControl)
+		name = original
+	}
+	if _, ok := assets.hashed[name]; !ok {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	http.ServeFileFS(c.Writer, c.Request, assets.fsys, name)
+}
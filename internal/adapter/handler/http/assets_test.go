@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetManifest(t *testing.T) {
+	m, err := newAssetManifest(fstest.MapFS{
+		"logos/go.svg": {Data: []byte("<svg/>")},
+	}, assetsPrefix)
+	require.NoError(t, err)
+
+	url := m.URL("/assets/logos/go.svg")
+	assert.Regexp(t, `^/assets/logos/go\.[0-9a-f]{10}\.svg$`, url)
+	assert.Equal(t, url, m.URL("logos/go.svg"))
+	assert.Equal(t, "https://example.com/logo.svg", m.URL("https://example.com/logo.svg"))
+	assert.Equal(t, "/assets/missing.svg", m.URL("/assets/missing.svg"))
+
+	relative, err := newAssetManifest(fstest.MapFS{"logos/go.svg": {Data: []byte("<svg/>")}}, "assets/")
+	require.NoError(t, err)
+	assert.Equal(t, strings.TrimPrefix(url, "/"), relative.URL("/assets/logos/go.svg"))
+}
+
+func TestHandleAsset(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	m, err := newAssetManifest(fstest.MapFS{
+		"logos/go.svg": {Data: []byte("<svg/>")},
+	}, assetsPrefix)
+	require.NoError(t, err)
+
+	r := &Router{}
+	r.pages.Store(&Pages{assets: m})
+	g := gin.New()
+	g.GET("/assets/*filepath", r.HandleAsset)
+
+	tests := []struct {
+		name         string
+		path         string
+		expected     int
+		cacheControl string
+	}{
+		{"hashed", m.URL("logos/go.svg"), http.StatusOK, immutableCacheControl},
+		{"plain", "/assets/logos/go.svg", http.StatusOK, ""},
+		{"stale hash", "/assets/logos/go.0000000000.svg", http.StatusNotFound, ""},
+		{"missing", "/assets/logos/rust.svg", http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			assert.Equal(t, tt.expected, w.Code)
+			assert.Equal(t, tt.cacheControl, w.Header().Get("Cache-Control"))
+			if tt.expected == http.StatusOK {
+				assert.Equal(t, "<svg/>", w.Body.String())
+			}
+		})
+	}
+}
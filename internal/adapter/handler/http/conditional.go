@@ -0,0 +1,75 @@
+package http
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// writeConditional writes body with a strong ETag computed from its content, Last-Modified set
+// to modified, and the configured Cache-Control unless the route set its own. A GET or HEAD
+// whose validators match gets 304 Not Modified without the body. Bodies carrying the CSP
+// nonce are sent with no-store instead.
+func (r *Router) writeConditional(c *gin.Context, contentType string, modified time.Time, body []byte) {
+	h := c.Writer.Header()
+	// A body carrying the CSP nonce belongs to this response alone. A cache revalidating it
+	// would keep the old body under the new policy, whose nonce no longer matches, so it is
+	// not cached at all.
+	if nonce := cspNonce(c.Request.Context()); nonce != "" && bytes.Contains(body, []byte(nonce)) {
+		h.Set("Cache-Control", "no-store")
+		c.Data(http.StatusOK, contentType, body)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+
+	h.Set("ETag", etag)
+	if r.cacheControl != "" && h.Get("Cache-Control") == "" {
+		h.Set("Cache-Control", r.cacheControl)
+	}
+	if !modified.IsZero() {
+		h.Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+	}
+
+	if (c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead) && notModified(c.Request, etag, modified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, contentType, body)
+}
+
+// notModified evaluates If-None-Match and, only when it is absent, If-Modified-Since, as
+// RFC 9110 section 13.2.2 orders them.
+func notModified(req *http.Request, etag string, modified time.Time) bool {
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		return etagMatches(inm, etag)
+	}
+	ims := req.Header.Get("If-Modified-Since")
+	if ims == "" || modified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	// HTTP dates have a resolution of one second.
+	return !modified.Truncate(time.Second).After(since)
+}
+
+// etagMatches reports whether an If-None-Match list contains etag, using the weak comparison
+// If-None-Match calls for.
+func etagMatches(list, etag string) bool {
+	for candidate := range strings.SplitSeq(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
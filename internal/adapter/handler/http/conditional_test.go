@@ -0,0 +1,74 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteConditional(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	modified := time.Date(2026, 3, 1, 12, 0, 0, 500, time.UTC)
+
+	r := &Router{cacheControl: "public, no-cache"}
+	g := gin.New()
+	g.GET("/", func(c *gin.Context) { r.writeConditional(c, "text/plain", modified, []byte("hello")) })
+
+	serve := func(header, value string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, req)
+		return w
+	}
+
+	first := serve("", "")
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	assert.Regexp(t, `^"[A-Za-z0-9_-]+"$`, etag)
+	assert.Equal(t, "Sun, 01 Mar 2026 12:00:00 GMT", first.Header().Get("Last-Modified"))
+	assert.Equal(t, "public, no-cache", first.Header().Get("Cache-Control"))
+	assert.Equal(t, "hello", first.Body.String())
+
+	tests := []struct {
+		name     string
+		header   string
+		value    string
+		expected int
+	}{
+		{"matching etag", "If-None-Match", etag, http.StatusNotModified},
+		{"matching etag in list", "If-None-Match", `"other", W/` + etag, http.StatusNotModified},
+		{"wildcard", "If-None-Match", "*", http.StatusNotModified},
+		{"stale etag", "If-None-Match", `"other"`, http.StatusOK},
+		{"not modified since", "If-Modified-Since", "Sun, 01 Mar 2026 12:00:00 GMT", http.StatusNotModified},
+		{"modified since", "If-Modified-Since", "Sun, 01 Mar 2026 11:59:59 GMT", http.StatusOK},
+		{"malformed date", "If-Modified-Since", "yesterday", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(tt.header, tt.value)
+			assert.Equal(t, tt.expected, w.Code)
+			assert.Equal(t, etag, w.Header().Get("ETag"))
+			if tt.expected == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestNotModifiedPrefersETag(t *testing.T) {
+	modified := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", `"stale"`)
+	req.Header.Set("If-Modified-Since", modified.Format(http.TimeFormat))
+
+	assert.False(t, notModified(req, `"current"`, modified))
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/export"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// HandleCV serves the whole CV as JSON, in the seed file structure.
+func (r *Router) HandleCV(c *gin.Context) {
+	r.writeExport(c, export.FormatJSON, false)
+}
+
+// HandleExport serves the CV as a downloadable document, e.g. /export/pdf.
+func (r *Router) HandleExport(c *gin.Context) {
+	format := c.Param("format")
+	if !slices.Contains(export.Formats, format) {
+		abortWithError(c, http.StatusNotFound, "unknown export format", nil)
+		return
+	}
+	r.writeExport(c, format, true)
+}
+
+func (r *Router) writeExport(c *gin.Context, format string, attachment bool) {
+	cv, err := r.cvSvc.GetCV(c.Request.Context())
+	if err != nil {
+		abortWithError(c, http.StatusInternalServerError, "", fmt.Errorf("loading CV: %w", err))
+		return
+	}
+
+	filename := ""
+	if attachment {
+		filename = "cv." + format
+	}
+	r.writeDocument(c, cv, format, filename)
+}
+
+// writeDocument writes cv in format, as an attachment named filename unless it is empty.
+func (r *Router) writeDocument(c *gin.Context, cv domain.CV, format, filename string) {
+	var doc bytes.Buffer
+	if err := export.Write(&doc, format, cv); err != nil {
+		detail := ""
+		if errors.Is(err, export.ErrUnsupportedText) {
+			detail = "the CV has characters this format cannot show; try another format"
+		}
+		abortWithError(c, http.StatusInternalServerError, detail, fmt.Errorf("exporting CV as %s: %w", format, err))
+		return
+	}
+
+	if filename != "" {
+		c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	}
+	r.writeConditional(c, export.ContentType(format), cv.LastModified(), doc.Bytes())
+}
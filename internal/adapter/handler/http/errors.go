@@ -0,0 +1,128 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/logging"
+)
+
+// problemContentType is the media type of RFC 9457 problem details.
+const problemContentType = "application/problem+json"
+
+// problem is an RFC 9457 problem details object.
+type problem struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Instance  string `json:"instance,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// errorPage is the data of the error.html template.
+type errorPage struct {
+	Status    int
+	Title     string
+	Message   string
+	RequestID string
+	// Nonce is the CSP nonce of the page's scripts.
+	Nonce string
+}
+
+// abortWithError stops the request with status, leaving the response to errorResponses. err,
+// if not nil, is logged but never sent; detail, if not empty, is shown to the client and must
+// not reveal anything internal.
+func abortWithError(c *gin.Context, status int, detail string, err error) {
+	if err != nil {
+		_ = c.Error(err)
+	}
+	if detail != "" {
+		_ = c.Error(errors.New(detail)).SetType(gin.ErrorTypePublic)
+	}
+	// The status is only set, not written, so errorResponses can still write the body.
+	c.Status(status)
+	c.Abort()
+}
+
+// errorResponses writes the body of every error response a handler left empty: an HTML page
+// for browsers and problem details for API clients. It logs the errors recorded on the request,
+// with its request ID, and only ever sends the public ones.
+func (r *Router) errorResponses() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		status := c.Writer.Status()
+		ctx := c.Request.Context()
+		for _, err := range c.Errors.ByType(gin.ErrorTypePrivate) {
+			slog.ErrorContext(ctx, "request failed", "status", status, "error", err.Err)
+		}
+		if status < http.StatusBadRequest || c.Writer.Written() {
+			return
+		}
+
+		c.Header("Cache-Control", "no-store")
+		requestID := logging.RequestID(ctx)
+		detail := strings.Join(c.Errors.ByType(gin.ErrorTypePublic).Errors(), "; ")
+		if !wantsHTML(c) {
+			// c.JSON keeps a Content-Type that is already set.
+			c.Header("Content-Type", problemContentType)
+			c.JSON(status, problem{
+				Type:      "about:blank",
+				Title:     http.StatusText(status),
+				Status:    status,
+				Detail:    detail,
+				Instance:  c.Request.URL.Path,
+				RequestID: requestID,
+			})
+			return
+		}
+
+		title, message := errorText(status)
+		page, err := r.pages.Load().Error(c.Query("theme"), errorPage{
+			Status:    status,
+			Title:     title,
+			Message:   message,
+			RequestID: requestID,
+			Nonce:     cspNonce(ctx),
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "rendering error page", "error", err)
+			page = []byte(fallbackErrorPage)
+		}
+		c.Data(status, "text/html; charset=utf-8", page)
+	}
+}
+
+// wantsHTML reports whether the client prefers an HTML page over JSON. Without an Accept
+// header, API routes answer with JSON and every other route with HTML.
+func wantsHTML(c *gin.Context) bool {
+	offers := []string{gin.MIMEHTML, problemContentType, gin.MIMEJSON}
+	if strings.HasPrefix(c.Request.URL.Path, "/api/") {
+		offers = []string{problemContentType, gin.MIMEJSON, gin.MIMEHTML}
+	}
+	return c.NegotiateFormat(offers...) == gin.MIMEHTML
+}
+
+// errorText returns the title and message of the error page for status.
+func errorText(status int) (string, string) {
+	switch status {
+	case http.StatusNotFound:
+		return "Page not found", "The page you are looking for does not exist."
+	case http.StatusServiceUnavailable:
+		return "Down for maintenance", "This site is being updated and will be back shortly."
+	}
+	if status < http.StatusInternalServerError {
+		return http.StatusText(status), "The request could not be handled."
+	}
+	return "Something went wrong", "The page could not be shown. Please try again later."
+}
+
+// fallbackErrorPage is served when the error page template itself fails.
+const fallbackErrorPage = `<!DOCTYPE html>
+<html lang="en"><head><meta charset="UTF-8"><title>Something went wrong</title></head>
+<body><h1>Something went wrong</h1><p>The page could not be shown. Please try again later.</p></body></html>
+`
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newErrorTestEngine(t *testing.T, middleware ...gin.HandlerFunc) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	pages, err := LoadPages(config.ThemeConfig{Name: "default"}, assetsPrefix)
+	require.NoError(t, err)
+
+	r := &Router{}
+	r.pages.Store(pages)
+	g := gin.New()
+	g.Use(requestID(), r.errorResponses(), recovery())
+	g.Use(middleware...)
+	g.GET("/", func(c *gin.Context) {
+		abortWithError(c, http.StatusInternalServerError, "", errors.New("secret connection string"))
+	})
+	g.GET("/api/cv", func(c *gin.Context) {
+		abortWithError(c, http.StatusInternalServerError, "", errors.New("secret connection string"))
+	})
+	g.GET("/export/:format", func(c *gin.Context) {
+		abortWithError(c, http.StatusNotFound, "unknown export format", nil)
+	})
+	g.GET("/panic", func(c *gin.Context) { panic("secret panic value") })
+	g.GET("/healthz", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"status": "ok"}) })
+	return g
+}
+
+func TestErrorResponses(t *testing.T) {
+	g := newErrorTestEngine(t)
+
+	tests := []struct {
+		name        string
+		path        string
+		accept      string
+		status      int
+		contentType string
+		contains    string
+	}{
+		{"browser server error", "/", "text/html,application/xhtml+xml,*/*;q=0.8", http.StatusInternalServerError, "text/html", "Something went wrong"},
+		{"api server error", "/api/cv", "", http.StatusInternalServerError, problemContentType, `"title":"Internal Server Error"`},
+		{"api error asked as html", "/api/cv", "text/html", http.StatusInternalServerError, "text/html", "Something went wrong"},
+		{"page error asked as json", "/", "application/json", http.StatusInternalServerError, problemContentType, `"status":500`},
+		{"browser not found", "/missing", "text/html", http.StatusNotFound, "text/html", "Page not found"},
+		{"api not found", "/api/missing", "", http.StatusNotFound, problemContentType, `"instance":"/api/missing"`},
+		{"public detail", "/export/docx", "application/json", http.StatusNotFound, problemContentType, `"detail":"unknown export format"`},
+		{"panic", "/panic", "text/html", http.StatusInternalServerError, "text/html", "Something went wrong"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			req.Header.Set(RequestIDHeader, "req-123")
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Contains(t, w.Header().Get("Content-Type"), tt.contentType)
+			assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+			assert.Contains(t, w.Body.String(), tt.contains)
+			assert.Contains(t, w.Body.String(), "req-123")
+			assert.NotContains(t, w.Body.String(), "secret")
+		})
+	}
+}
+
+func TestErrorResponsesProblemDetails(t *testing.T) {
+	g := newErrorTestEngine(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/export/docx", nil)
+	req.Header.Set("Accept", problemContentType)
+	req.Header.Set(RequestIDHeader, "req-123")
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, req)
+
+	var got problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Equal(t, problem{
+		Type:      "about:blank",
+		Title:     "Not Found",
+		Status:    http.StatusNotFound,
+		Detail:    "unknown export format",
+		Instance:  "/export/docx",
+		RequestID: "req-123",
+	}, got)
+}
+
+func TestStarting(t *testing.T) {
+	var ready bool
+	g := newErrorTestEngine(t, starting(func() bool { return ready }))
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	w := get("/export/pdf")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "5", w.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusServiceUnavailable, get("/").Code)
+	assert.Equal(t, http.StatusOK, get("/healthz").Code)
+
+	ready = true
+	assert.Equal(t, http.StatusNotFound, get("/export/pdf").Code)
+}
+
+func TestMaintenance(t *testing.T) {
+	g := newErrorTestEngine(t, maintenance())
+
+	tests := []struct {
+		name        string
+		path        string
+		accept      string
+		status      int
+		contentType string
+	}{
+		{"page", "/", "text/html", http.StatusServiceUnavailable, "text/html"},
+		{"api", "/api/cv", "", http.StatusServiceUnavailable, problemContentType},
+		{"health check", "/healthz", "", http.StatusOK, "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Contains(t, w.Header().Get("Content-Type"), tt.contentType)
+			if tt.status == http.StatusServiceUnavailable {
+				assert.Equal(t, "300", w.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+)
+
+// HandleLiveness reports whether the process is up, without checking dependencies.
+func (r *Router) HandleLiveness(c *gin.Context) {
+	r.healthReport(c, r.health.Live(c.Request.Context()))
+}
+
+// HandleReadiness reports whether the instance can serve traffic: the database answers,
+// migrations are at the latest version, seeding has completed and shutdown has not started.
+func (r *Router) HandleReadiness(c *gin.Context) {
+	r.healthReport(c, r.health.Ready(c.Request.Context()))
+}
+
+func (r *Router) healthReport(c *gin.Context, report service.HealthReport) {
+	c.Header("Cache-Control", "no-store")
+	code := http.StatusOK
+	if !report.OK() {
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, report)
+}
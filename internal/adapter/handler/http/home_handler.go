@@ -6,24 +6,23 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// HandleHome renders the home page, in the theme named by the theme query parameter if any.
 func (r *Router) HandleHome(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	skills, err := r.cvSvc.GetSkills(ctx)
+	// The page is rendered up front, as its ETag is the hash of its content.
+	page, err := r.pages.Load().Home(ctx, r.cvSvc, c.Query("theme"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		abortWithError(c, http.StatusInternalServerError, "", err)
 		return
 	}
 
-	experiences, err := r.cvSvc.GetExperiences(ctx)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	// A page missing a section must not be cached, or clients would keep it once the
+	// section is back.
+	if page.Degraded {
+		c.Header("Cache-Control", "no-store")
+		c.Data(http.StatusOK, "text/html; charset=utf-8", page.Body)
 		return
 	}
-
-	c.HTML(http.StatusOK, "index.html", gin.H{
-		"Title":       "Mi CvService - Platform Engineer",
-		"Skills":      skills,
-		"Experiences": experiences,
-	})
+	r.writeConditional(c, "text/html; charset=utf-8", page.Modified, page.Body)
 }
@@ -0,0 +1,155 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+	"golang.org/x/sync/errgroup"
+)
+
+// Sections of the home page, as the template's Unavailable map names them.
+const (
+	sectionSkills       = "skills"
+	sectionExperiences  = "experiences"
+	sectionAchievements = "achievements"
+	sectionProjects     = "projects"
+)
+
+// Page is a rendered page.
+type Page struct {
+	Body []byte
+	// Modified is the time the content shown was last modified.
+	Modified time.Time
+	// Degraded is set when a section failed to load and was left out of the page.
+	Degraded bool
+}
+
+// experienceView is an experience with the achievements linked to it.
+type experienceView struct {
+	domain.Experience
+	Achievements []domain.Achievement
+}
+
+// projectView is a project with the achievements linked to it.
+type projectView struct {
+	domain.Project
+	Achievements []domain.Achievement
+}
+
+// Home renders the home page in the named theme, or the configured one if the name is empty
+// or unknown. The sections are loaded concurrently; one that fails is logged and shown as
+// unavailable, and the page is marked degraded, rather than failing the whole page.
+func (p *Pages) Home(ctx context.Context, cv port.CVReader, theme string) (Page, error) {
+	// A failing section must neither cancel nor fail the others, so each keeps its own error
+	// and none is returned to the group.
+	var (
+		content                                                 domain.CV
+		skillsErr, experiencesErr, achievementsErr, projectsErr error
+		g                                                       errgroup.Group
+	)
+	g.Go(func() error { content.Skills, skillsErr = cv.GetSkills(ctx); return nil })
+	g.Go(func() error { content.Experiences, experiencesErr = cv.GetExperiences(ctx); return nil })
+	g.Go(func() error { content.Achievements, achievementsErr = cv.GetAchievements(ctx); return nil })
+	g.Go(func() error { content.Projects, projectsErr = cv.GetProjects(ctx); return nil })
+	_ = g.Wait()
+
+	unavailable := make(map[string]bool)
+	for section, err := range map[string]error{
+		sectionSkills:       skillsErr,
+		sectionExperiences:  experiencesErr,
+		sectionAchievements: achievementsErr,
+		sectionProjects:     projectsErr,
+	} {
+		if err != nil {
+			unavailable[section] = true
+			slog.WarnContext(ctx, "home page section unavailable", "section", section, "error", err)
+		}
+	}
+
+	experiences, projects, standalone := groupAchievements(content.Experiences, content.Projects, content.Achievements)
+
+	var page bytes.Buffer
+	err := p.template(theme).ExecuteTemplate(&page, "index.html", gin.H{
+		"Title":        "Mi CvService - Platform Engineer",
+		"Skills":       content.Skills,
+		"Experiences":  experiences,
+		"Projects":     projects,
+		"Achievements": standalone,
+		"Unavailable":  unavailable,
+		"Nonce":        cspNonce(ctx),
+	})
+	if err != nil {
+		return Page{}, fmt.Errorf("rendering home page: %w", err)
+	}
+	return Page{Body: page.Bytes(), Modified: content.LastModified(), Degraded: len(unavailable) > 0}, nil
+}
+
+// groupAchievements attaches every achievement to the experience and the project it is linked
+// to. Achievements linked to neither, or only to ones not shown, are returned as standalone.
+func groupAchievements(experiences []domain.Experience, projects []domain.Project, achievements []domain.Achievement) ([]experienceView, []projectView, []domain.Achievement) {
+	expViews := make([]experienceView, len(experiences))
+	expIndex := make(map[int32]int, len(experiences))
+	for i, e := range experiences {
+		expViews[i] = experienceView{Experience: e}
+		expIndex[e.ID] = i
+	}
+	projViews := make([]projectView, len(projects))
+	projIndex := make(map[int32]int, len(projects))
+	for i, p := range projects {
+		projViews[i] = projectView{Project: p}
+		projIndex[p.ID] = i
+	}
+
+	var standalone []domain.Achievement
+	for _, a := range achievements {
+		placed := false
+		if a.IsLinkedToExperience() {
+			if i, ok := expIndex[*a.ExperienceID]; ok {
+				expViews[i].Achievements = append(expViews[i].Achievements, a)
+				placed = true
+			}
+		}
+		if a.IsLinkedToProject() {
+			if i, ok := projIndex[*a.ProjectID]; ok {
+				projViews[i].Achievements = append(projViews[i].Achievements, a)
+				placed = true
+			}
+		}
+		if !placed {
+			standalone = append(standalone, a)
+		}
+	}
+	return expViews, projViews, standalone
+}
+
+// formatDuration renders a duration in whole years and months, e.g. "1 yr 3 mos", as CVs
+// usually show it. Durations under a month read "< 1 mo".
+func formatDuration(d time.Duration) string {
+	const month = 730 * time.Hour // a twelfth of a 365-day year
+	months := int(d / month)
+	years, months := months/12, months%12
+
+	switch {
+	case years == 0 && months == 0:
+		return "< 1 mo"
+	case years == 0:
+		return plural(months, "mo", "mos")
+	case months == 0:
+		return plural(years, "yr", "yrs")
+	default:
+		return plural(years, "yr", "yrs") + " " + plural(months, "mo", "mos")
+	}
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, one)
+	}
+	return fmt.Sprintf("%d %s", n, many)
+}
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingSections fails the named sections and reads the others from the embedded reader.
+type failingSections struct {
+	port.CVReader
+	failing map[string]bool
+}
+
+var errSectionDown = errors.New("section down")
+
+func (f failingSections) GetSkills(ctx context.Context) ([]domain.Skill, error) {
+	if f.failing[sectionSkills] {
+		return nil, errSectionDown
+	}
+	return f.CVReader.GetSkills(ctx)
+}
+
+func (f failingSections) GetExperiences(ctx context.Context) ([]domain.Experience, error) {
+	if f.failing[sectionExperiences] {
+		return nil, errSectionDown
+	}
+	return f.CVReader.GetExperiences(ctx)
+}
+
+func (f failingSections) GetAchievements(ctx context.Context) ([]domain.Achievement, error) {
+	if f.failing[sectionAchievements] {
+		return nil, errSectionDown
+	}
+	return f.CVReader.GetAchievements(ctx)
+}
+
+func (f failingSections) GetProjects(ctx context.Context) ([]domain.Project, error) {
+	if f.failing[sectionProjects] {
+		return nil, errSectionDown
+	}
+	return f.CVReader.GetProjects(ctx)
+}
+
+func TestHomeDegradesFailingSections(t *testing.T) {
+	pages, err := LoadPages(config.ThemeConfig{Name: "default"}, assetsPrefix)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		failing   map[string]bool
+		contains  []string
+		missing   []string
+		cacheable bool
+	}{
+		{
+			name:      "all sections",
+			contains:  []string{"Acme", "This site", "Shipped it", "Category: Language"},
+			missing:   []string{"could not be loaded"},
+			cacheable: true,
+		},
+		{
+			name:     "projects down",
+			failing:  map[string]bool{sectionProjects: true},
+			contains: []string{"Acme", "Projects could not be loaded", "Shipped it", "Category: Language"},
+			missing:  []string{"This site"},
+		},
+		{
+			name:     "achievements down",
+			failing:  map[string]bool{sectionAchievements: true},
+			contains: []string{"Acme", "This site", "Achievements could not be loaded"},
+			missing:  []string{"Shipped it"},
+		},
+		{
+			name: "everything down",
+			failing: map[string]bool{
+				sectionSkills: true, sectionExperiences: true, sectionAchievements: true, sectionProjects: true,
+			},
+			contains: []string{"Experience could not be loaded", "Skills could not be loaded"},
+			missing:  []string{"Acme"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			r := &Router{cvSvc: failingSections{CVReader: testCV(), failing: tt.failing}, cacheControl: "public, no-cache"}
+			r.pages.Store(pages)
+			g := gin.New()
+			g.GET("/", r.HandleHome)
+
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Contains(t, w.Header().Get("Content-Type"), "text/html")
+			for _, s := range tt.contains {
+				assert.Contains(t, w.Body.String(), s)
+			}
+			for _, s := range tt.missing {
+				assert.NotContains(t, w.Body.String(), s)
+			}
+			if tt.cacheable {
+				assert.NotEmpty(t, w.Header().Get("ETag"))
+			} else {
+				assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+				assert.Empty(t, w.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestGroupAchievements(t *testing.T) {
+	id := func(n int32) *int32 { return &n }
+	experiences := []domain.Experience{{ID: 1}, {ID: 2}}
+	projects := []domain.Project{{ID: 10}}
+	achievements := []domain.Achievement{
+		{Title: "at job", ExperienceID: id(1)},
+		{Title: "on project", ProjectID: id(10)},
+		{Title: "both", ExperienceID: id(2), ProjectID: id(10)},
+		{Title: "standalone"},
+		{Title: "orphan", ExperienceID: id(99)},
+	}
+
+	exps, projs, standalone := groupAchievements(experiences, projects, achievements)
+
+	titles := func(as []domain.Achievement) []string {
+		var result []string
+		for _, a := range as {
+			result = append(result, a.Title)
+		}
+		return result
+	}
+	assert.Equal(t, []string{"at job"}, titles(exps[0].Achievements))
+	assert.Equal(t, []string{"both"}, titles(exps[1].Achievements))
+	assert.Equal(t, []string{"on project", "both"}, titles(projs[0].Achievements))
+	assert.Equal(t, []string{"standalone", "orphan"}, titles(standalone))
+}
+
+func TestFormatDuration(t *testing.T) {
+	const month = 730 * time.Hour
+	tests := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{0, "< 1 mo"},
+		{20 * 24 * time.Hour, "< 1 mo"},
+		{month, "1 mo"},
+		{5 * month, "5 mos"},
+		{12 * month, "1 yr"},
+		{13 * month, "1 yr 1 mo"},
+		{27 * month, "2 yrs 3 mos"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatDuration(tt.duration))
+		})
+	}
+}
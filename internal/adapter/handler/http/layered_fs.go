@@ -0,0 +1,51 @@
+package http
+
+import (
+	"errors"
+	"io/fs"
+	"maps"
+	"slices"
+)
+
+// layeredFS stacks file systems: a file is read from the first layer that has it, so earlier
+// layers override later ones, and directories list the files of every layer. A layer that
+// lacks a directory, or does not exist at all, is skipped.
+type layeredFS []fs.FS
+
+func (l layeredFS) Open(name string) (fs.File, error) {
+	for _, layer := range l {
+		f, err := layer.Open(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		return f, err
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (l layeredFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entries := make(map[string]fs.DirEntry)
+	found := false
+	// Walk the layers bottom up so overriding entries replace the ones below.
+	for _, layer := range slices.Backward(l) {
+		list, err := fs.ReadDir(layer, name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		found = true
+		for _, e := range list {
+			entries[e.Name()] = e
+		}
+	}
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	result := make([]fs.DirEntry, 0, len(entries))
+	for _, n := range slices.Sorted(maps.Keys(entries)) {
+		result = append(result, entries[n])
+	}
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// How long clients are told to wait during maintenance and while the instance starts, in seconds.
+const (
+	maintenanceRetryAfter = 300
+	startingRetryAfter    = 5
+)
+
+// maintenance answers every request with 503 while the site is down for maintenance, except
+// the health checks, metrics and the assets the error page links.
+func maintenance() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		if path == "/healthz" || path == "/readyz" || path == "/metrics" || strings.HasPrefix(path, assetsPrefix) {
+			c.Next()
+			return
+		}
+		c.Header("Retry-After", strconv.Itoa(maintenanceRetryAfter))
+		abortWithError(c, http.StatusServiceUnavailable, "down for maintenance", nil)
+	}
+}
+
+// starting answers every request with 503 until ready reports that migrations and the startup
+// seed run have completed, so nothing reads a half-migrated schema. Only the health checks are
+// served meanwhile.
+func starting(ready func() bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		if ready() || path == "/healthz" || path == "/readyz" {
+			c.Next()
+			return
+		}
+		c.Header("Retry-After", strconv.Itoa(startingRetryAfter))
+		abortWithError(c, http.StatusServiceUnavailable, "starting up", nil)
+	}
+}
@@ -0,0 +1,148 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/ui"
+)
+
+// templatePatterns are the template files of a theme: the pages, and the partials they share.
+var templatePatterns = []string{"*.html", "partials/*.html"}
+
+// Pages renders the HTML pages from the templates, linking assets by content-hashed URL.
+// The server and the static site build share it, so both render identical pages.
+type Pages struct {
+	themes map[string]*template.Template
+	// theme is rendered when no theme, or an unknown one, is asked for.
+	theme  string
+	assets *assetManifest
+}
+
+// LoadPages parses the built-in themes and hashes the assets, layering the templates and
+// assets of cfg.Dir over them when it is set. assetsURL is the URL the assets are served
+// under: "/assets/" on the server, or the relative "assets/" in a static build so it works
+// from any subpath.
+func LoadPages(cfg config.ThemeConfig, assetsURL string) (*Pages, error) {
+	themes, err := fs.Sub(ui.Themes, "themes")
+	if err != nil {
+		return nil, err
+	}
+	assets, err := fs.Sub(ui.Assets, "assets")
+	if err != nil {
+		return nil, err
+	}
+
+	var override fs.FS
+	if cfg.Dir != "" {
+		info, err := os.Stat(cfg.Dir)
+		if err != nil {
+			return nil, fmt.Errorf("theme directory: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("theme directory: %s is not a directory", cfg.Dir)
+		}
+		override = os.DirFS(cfg.Dir)
+	}
+	return newPages(themes, assets, override, cfg.Name, assetsURL)
+}
+
+// newPages parses every theme directory of themes, layered over the default theme and under
+// override, which may be nil.
+func newPages(themes, assets, override fs.FS, theme, assetsURL string) (*Pages, error) {
+	if override != nil {
+		sub, err := fs.Sub(override, AssetsDir)
+		if err != nil {
+			return nil, err
+		}
+		assets = layeredFS{sub, assets}
+	}
+	manifest, err := newAssetManifest(assets, assetsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := fs.ReadDir(themes, ".")
+	if err != nil {
+		return nil, fmt.Errorf("listing themes: %w", err)
+	}
+	base, err := fs.Sub(themes, ui.DefaultTheme)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Pages{themes: make(map[string]*template.Template), theme: theme, assets: manifest}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		dir, err := fs.Sub(themes, e.Name())
+		if err != nil {
+			return nil, err
+		}
+		layers := layeredFS{dir, base}
+		if override != nil {
+			layers = layeredFS{override, dir, base}
+		}
+		t, err := parseTheme(layers, manifest)
+		if err != nil {
+			return nil, fmt.Errorf("parsing theme %s: %w", e.Name(), err)
+		}
+		p.themes[e.Name()] = t
+	}
+	if _, ok := p.themes[theme]; !ok {
+		return nil, fmt.Errorf("unknown theme %q: use one of %v", theme, p.Themes())
+	}
+	return p, nil
+}
+
+func parseTheme(fsys fs.FS, assets *assetManifest) (*template.Template, error) {
+	t := template.New("").Funcs(template.FuncMap{"asset": assets.URL, "duration": formatDuration})
+	for _, pattern := range templatePatterns {
+		// ParseFS fails on a pattern without matches, and a theme need not have partials.
+		matches, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		if t, err = t.ParseFS(fsys, matches...); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// template returns the named theme, or the configured one if the name is empty or unknown.
+func (p *Pages) template(theme string) *template.Template {
+	if t, ok := p.themes[theme]; ok {
+		return t
+	}
+	return p.themes[p.theme]
+}
+
+// Error renders the error page in the named theme, or the configured one.
+func (p *Pages) Error(theme string, data errorPage) ([]byte, error) {
+	var page bytes.Buffer
+	if err := p.template(theme).ExecuteTemplate(&page, "error.html", data); err != nil {
+		return nil, fmt.Errorf("rendering error page: %w", err)
+	}
+	return page.Bytes(), nil
+}
+
+// Themes returns the names of the available themes.
+func (p *Pages) Themes() []string {
+	return slices.Sorted(maps.Keys(p.themes))
+}
+
+// Assets returns the asset files, and their content-hashed names by name.
+func (p *Pages) Assets() (fs.FS, map[string]string) {
+	return p.assets.fsys, maps.Clone(p.assets.hashed)
+}
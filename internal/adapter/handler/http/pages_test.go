@@ -0,0 +1,162 @@
+package http
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/memory"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCV() *service.CVService {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	projectID := int32(1)
+	return service.NewCVService(memory.NewStore(domain.CV{
+		Skills: []domain.Skill{{Name: "Go", Category: "Language", Proficiency: 90, LogoPath: "/assets/logos/go.svg"}},
+		Experiences: []domain.Experience{{
+			CompanyName: "Acme",
+			JobTitle:    "Engineer",
+			StartDate:   time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+			Skills:      []domain.Skill{{Name: "Go"}},
+		}},
+		Projects: []domain.Project{{ID: 1, Name: "CV", Description: "This site", StartDate: &start}},
+		Achievements: []domain.Achievement{
+			{Title: "Shipped it", Description: "Launched the site", ProjectID: &projectID},
+		},
+	}))
+}
+
+func TestLoadPagesBuiltInThemes(t *testing.T) {
+	pages, err := LoadPages(config.ThemeConfig{Name: "default"}, assetsPrefix)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default", "light", "minimal"}, pages.Themes())
+
+	tests := []struct {
+		theme    string
+		expected string
+	}{
+		{"", `data-theme="dark"`},
+		{"default", `data-theme="dark"`},
+		{"light", `data-theme="corporate"`},
+		{"minimal", `data-theme="lofi"`},
+		{"unknown", `data-theme="dark"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.theme, func(t *testing.T) {
+			page, err := pages.Home(context.Background(), testCV(), tt.theme)
+			require.NoError(t, err)
+			assert.False(t, page.Degraded)
+			body := string(page.Body)
+			assert.Contains(t, body, tt.expected)
+			assert.Contains(t, body, "Acme")
+			assert.Contains(t, body, "This site")
+			assert.Contains(t, body, "Shipped it")
+			assert.Contains(t, body, "Ongoing")
+			assert.Regexp(t, `src="/assets/logos/go\.[0-9a-f]{10}\.svg"`, body)
+		})
+	}
+}
+
+func TestLoadPagesUnknownTheme(t *testing.T) {
+	_, err := LoadPages(config.ThemeConfig{Name: "neon"}, assetsPrefix)
+	assert.ErrorContains(t, err, `unknown theme "neon"`)
+}
+
+func TestLoadPagesThemeDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "partials"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "partials", "skill_card.html"),
+		[]byte(`<i class="custom-skill">{{ .Name }}</i>`), 0o644))
+
+	pages, err := LoadPages(config.ThemeConfig{Name: "light", Dir: dir}, assetsPrefix)
+	require.NoError(t, err)
+
+	page, err := pages.Home(context.Background(), testCV(), "")
+	require.NoError(t, err)
+	assert.Contains(t, string(page.Body), `<i class="custom-skill">Go</i>`)
+	assert.Contains(t, string(page.Body), `data-theme="corporate"`)
+
+	_, err = LoadPages(config.ThemeConfig{Name: "default", Dir: filepath.Join(dir, "missing")}, assetsPrefix)
+	assert.Error(t, err)
+}
+
+func TestNewPagesLayering(t *testing.T) {
+	themes := fstest.MapFS{
+		"default/index.html":             {Data: []byte(`{{ template "theme.html" }}|{{ template "card.html" }}`)},
+		"default/theme.html":             {Data: []byte(`default`)},
+		"default/partials/card.html":     {Data: []byte(`card`)},
+		"plain/theme.html":               {Data: []byte(`plain`)},
+		"fancy/partials/card.html":       {Data: []byte(`fancy card`)},
+		"fancy/partials/unused.html":     {Data: []byte(`unused`)},
+		"default/partials/ignored.txt":   {Data: []byte(`not a template`)},
+		"not-a-theme.txt":                {Data: []byte(`ignored`)},
+		"default/partials/nested/x.html": {Data: []byte(`not parsed`)},
+	}
+	assets := fstest.MapFS{
+		"logos/go.svg":   {Data: []byte("<svg>go</svg>")},
+		"logos/rust.svg": {Data: []byte("<svg>rust</svg>")},
+	}
+	override := fstest.MapFS{
+		"theme.html":          {Data: []byte(`custom`)},
+		"assets/logos/go.svg": {Data: []byte("<svg>custom</svg>")},
+	}
+
+	tests := []struct {
+		name     string
+		override fs.FS
+		expected map[string]string
+	}{
+		{
+			name: "built-in",
+			expected: map[string]string{
+				"default": "default|card",
+				"plain":   "plain|card",
+				"fancy":   "default|fancy card",
+			},
+		},
+		{
+			name:     "override",
+			override: override,
+			expected: map[string]string{
+				"default": "custom|card",
+				"plain":   "custom|card",
+				"fancy":   "custom|fancy card",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages, err := newPages(themes, assets, tt.override, "default", assetsPrefix)
+			require.NoError(t, err)
+			for theme, expected := range tt.expected {
+				page, err := pages.Home(context.Background(), testCV(), theme)
+				require.NoError(t, err)
+				assert.Equal(t, expected, string(page.Body), theme)
+			}
+
+			fsys, hashed := pages.Assets()
+			assert.Len(t, hashed, 2)
+			rust, err := fs.ReadFile(fsys, "logos/rust.svg")
+			require.NoError(t, err)
+			assert.Equal(t, "<svg>rust</svg>", string(rust))
+			goLogo, err := fs.ReadFile(fsys, "logos/go.svg")
+			require.NoError(t, err)
+			if tt.override != nil {
+				assert.Equal(t, "<svg>custom</svg>", string(goLogo))
+			} else {
+				assert.Equal(t, "<svg>go</svg>", string(goLogo))
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// rateLimiterIdle is how long the bucket of an IP is kept after its last request. By then a
+// bucket has refilled, so forgetting it changes nothing.
+const rateLimiterIdle = 10 * time.Minute
+
+// rateLimiter keeps a token bucket per client IP.
+type rateLimiter struct {
+	limit rate.Limit
+	burst int
+	now   func() time.Time
+
+	mu        sync.Mutex
+	clients   map[string]*clientBucket
+	lastSweep time.Time
+}
+
+type clientBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		limit:   rate.Limit(rps),
+		burst:   burst,
+		now:     time.Now,
+		clients: make(map[string]*clientBucket),
+	}
+}
+
+// reserve takes a token from the bucket of ip. It returns zero if the request may proceed,
+// or how long the client has to wait for a token.
+func (l *rateLimiter) reserve(ip string) time.Duration {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > rateLimiterIdle {
+		for key, b := range l.clients {
+			if now.Sub(b.lastSeen) > rateLimiterIdle {
+				delete(l.clients, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.clients[ip]
+	if !ok {
+		b = &clientBucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[ip] = b
+	}
+	b.lastSeen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay
+	}
+	return 0
+}
+
+// middleware answers 429 with Retry-After to clients over their rate. Health checks and
+// metrics scrapes are not limited. The client IP is gin's ClientIP, which only believes
+// X-Forwarded-For from trusted proxies.
+func (l *rateLimiter) middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.URL.Path {
+		case "/healthz", "/readyz", "/metrics":
+			c.Next()
+			return
+		}
+		if wait := l.reserve(c.ClientIP()); wait > 0 {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			abortWithError(c, http.StatusTooManyRequests, "rate limit exceeded", nil)
+			return
+		}
+		c.Next()
+	}
+}
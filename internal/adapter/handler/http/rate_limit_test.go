@@ -0,0 +1,91 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(1, 2)
+	l.now = func() time.Time { return now }
+
+	assert.Zero(t, l.reserve("10.0.0.1"))
+	assert.Zero(t, l.reserve("10.0.0.1"))
+	assert.Equal(t, time.Second, l.reserve("10.0.0.1"))
+	// A rejected request does not use up a token.
+	assert.Equal(t, time.Second, l.reserve("10.0.0.1"))
+	assert.Zero(t, l.reserve("10.0.0.2"), "every IP has its own bucket")
+
+	now = now.Add(time.Second)
+	assert.Zero(t, l.reserve("10.0.0.1"))
+
+	now = now.Add(rateLimiterIdle + time.Second)
+	l.reserve("10.0.0.3")
+	assert.Len(t, l.clients, 1, "idle buckets are dropped")
+}
+
+func TestRateLimiterMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		// forwarded requests come from the same proxy, on behalf of different clients.
+		forwarded []string
+		expected  []int
+	}{
+		{
+			name:      "direct clients",
+			forwarded: []string{"", "", ""},
+			expected:  []int{http.StatusOK, http.StatusTooManyRequests, http.StatusTooManyRequests},
+		},
+		{
+			name:           "trusted proxy",
+			trustedProxies: []string{"192.0.2.0/24"},
+			forwarded:      []string{"203.0.113.1", "203.0.113.2", "203.0.113.1"},
+			expected:       []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:      "untrusted proxy",
+			forwarded: []string{"203.0.113.1", "203.0.113.2", "203.0.113.3"},
+			expected:  []int{http.StatusOK, http.StatusTooManyRequests, http.StatusTooManyRequests},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gin.New()
+			require.NoError(t, g.SetTrustedProxies(tt.trustedProxies))
+			g.Use(newRateLimiter(0.001, 1).middleware())
+			g.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+			g.GET("/healthz", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			for i, forwardedFor := range tt.forwarded {
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				req.RemoteAddr = "192.0.2.10:4321"
+				if forwardedFor != "" {
+					req.Header.Set("X-Forwarded-For", forwardedFor)
+				}
+				w := httptest.NewRecorder()
+				g.ServeHTTP(w, req)
+				assert.Equal(t, tt.expected[i], w.Code, "request %d", i)
+				if w.Code == http.StatusTooManyRequests {
+					assert.Equal(t, "1000", w.Header().Get("Retry-After"))
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+			req.RemoteAddr = "192.0.2.10:4321"
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code, "health checks are not limited")
+		})
+	}
+}
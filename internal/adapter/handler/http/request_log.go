@@ -0,0 +1,91 @@
+package http
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/logging"
+)
+
+// RequestIDHeader carries the request ID, from a client or proxy and back in every response.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds an incoming request ID, which ends up in every log line.
+const maxRequestIDLength = 128
+
+// requestID propagates the incoming request ID, or generates one, into the request context
+// and the response headers.
+func requestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), id))
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// validRequestID accepts a non-empty, bounded ID of printable ASCII, so an ID cannot forge
+// log lines.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// requestLogger logs every request once it has been handled.
+func requestLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		slog.Log(c.Request.Context(), level, "request",
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"route", c.FullPath(),
+			"status", status,
+			"duration_ms", float64(time.Since(start).Microseconds())/1000,
+			"bytes", c.Writer.Size(),
+			"client_ip", c.ClientIP(),
+		)
+	}
+}
+
+// recovery turns a panic in a handler into a 500, left for errorResponses to write, and logs
+// it with its stack.
+func recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, err any) {
+		slog.ErrorContext(c.Request.Context(), "panic while handling request",
+			"error", err,
+			"stack", string(debug.Stack()),
+		)
+		abortWithError(c, http.StatusInternalServerError, "", nil)
+	})
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		incoming string
+		kept     bool
+	}{
+		{"propagated", "abc-123", true},
+		{"generated when missing", "", false},
+		{"replaced when it contains a newline", "abc\ninjected", false},
+		{"replaced when too long", strings.Repeat("a", maxRequestIDLength+1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fromContext string
+			g := gin.New()
+			g.GET("/", requestID(), func(c *gin.Context) {
+				fromContext = logging.RequestID(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, req)
+
+			id := w.Header().Get(RequestIDHeader)
+			assert.NotEmpty(t, id)
+			assert.Equal(t, id, fromContext)
+			assert.Equal(t, tt.kept, id == tt.incoming)
+		})
+	}
+}
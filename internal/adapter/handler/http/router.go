@@ -1,33 +1,146 @@
 package http
 
 import (
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/metrics"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
 	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"net/http"
+	"sync/atomic"
 )
 
+// Services are the application services the router's handlers call.
+type Services struct {
+	CV         port.CVReader
+	Migrations MigrationStateReader
+	Health     *service.HealthService
+	Metrics    *metrics.Metrics
+	// Cache reports the CV cache statistics; it is nil when caching is disabled.
+	Cache CacheStatsReader
+	// Audit is the audit log of content changes; the admin API omits it when nil.
+	Audit port.AuditLog
+	// Snapshots serves the CV snapshots under /v; the routes are omitted when nil.
+	Snapshots SnapshotReader
+	// AdminCV reads the whole CV, hidden and deleted records included; the admin API omits
+	// it when nil.
+	AdminCV port.CVReader
+}
+
 type Router struct {
-	engine *gin.Engine
-	cvSvc  *service.CVService
+	engine     *gin.Engine
+	cvSvc      port.CVReader
+	migrations MigrationStateReader
+	health     *service.HealthService
+	cache      CacheStatsReader
+	audit      port.AuditLog
+	snapshots  SnapshotReader
+	adminCV    port.CVReader
+	// cacheControl is sent with the pages, API and export responses.
+	cacheControl string
+	theme        config.ThemeConfig
+	pages        atomic.Pointer[Pages]
 }
 
-func NewRouter(cvSvc *service.CVService) *Router {
-	g := gin.Default()
+func NewRouter(cfg *config.Config, svc Services) *Router {
+	if !cfg.App.IsDevelopment() {
+		gin.SetMode(gin.ReleaseMode)
+	}
+	g := gin.New()
+	r := &Router{
+		engine:       g,
+		cvSvc:        svc.CV,
+		migrations:   svc.Migrations,
+		health:       svc.Health,
+		cache:        svc.Cache,
+		audit:        svc.Audit,
+		snapshots:    svc.Snapshots,
+		adminCV:      svc.AdminCV,
+		cacheControl: cfg.Server.CacheControl,
+		theme:        cfg.Theme,
+	}
 
-	g.Static("/assets", "./assets")
+	// Without trusted proxies, gin would believe X-Forwarded-For from any client.
+	if err := g.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		panic(err)
+	}
 
-	r := &Router{
-		engine: g,
-		cvSvc:  cvSvc,
+	// Tracing comes first so the metrics, the request log and every handler run inside the
+	// request span. Error responses are written before the request is logged, and after a
+	// recovered panic.
+	g.Use(
+		otelgin.Middleware(cfg.Tracing.ServiceName),
+		requestID(),
+		securityHeaders(cfg.Security),
+		requestLogger(),
+		r.errorResponses(),
+		recovery(),
+	)
+	if cfg.Metrics.Enabled {
+		g.Use(svc.Metrics.Middleware())
+		// On the public port, metrics are for admins only.
+		if cfg.Metrics.Addr == "" {
+			g.GET("/metrics", requireAdmin(cfg.Admin.Token), gin.WrapH(svc.Metrics.Handler()))
+		}
+	}
+	if cfg.RateLimit.Enabled {
+		g.Use(newRateLimiter(cfg.RateLimit.RPS, cfg.RateLimit.Burst).middleware())
+	}
+	g.Use(limitBody(cfg.Server.MaxBodyBytes))
+	if cfg.Server.Maintenance {
+		g.Use(maintenance())
 	}
+	g.Use(starting(svc.Health.Seeded))
 
-	g.LoadHTMLGlob("templates/*.html")
+	if err := r.ReloadTemplates(); err != nil {
+		panic(err)
+	}
 
 	g.GET("/", r.HandleHome)
+	g.GET("/assets/*filepath", r.HandleAsset)
+	g.HEAD("/assets/*filepath", r.HandleAsset)
+	g.GET("/api/cv", r.HandleCV)
+	g.GET("/export/:format", r.HandleExport)
+	g.GET("/schemas/:file", r.HandleSchema)
+	g.GET("/healthz", r.HandleLiveness)
+	g.GET("/readyz", r.HandleReadiness)
+
+	admin := g.Group("/api/admin", requireAdmin(cfg.Admin.Token))
+	admin.GET("/migrations", r.HandleMigrationStatus)
+	if r.cache != nil {
+		admin.GET("/cache", r.HandleCacheStats)
+	}
+	if r.audit != nil {
+		admin.GET("/audit", r.HandleAuditEvents)
+	}
+	if r.adminCV != nil {
+		admin.GET("/cv", r.HandleAdminCV)
+	}
+	// Snapshots are numbered in sequence and may hold records hidden since, so they are for
+	// admins only, and kept out of shared caches.
+	if r.snapshots != nil {
+		snapshots := g.Group("/v", requireAdmin(cfg.Admin.Token), privateResponses())
+		snapshots.GET("/:snapshot", r.HandleSnapshot)
+		snapshots.GET("/:snapshot/export/:format", r.HandleSnapshotExport)
+	}
 
 	return r
 }
 
+// ReloadTemplates re-parses the HTML templates and re-hashes the assets, picking up changes
+// to the theme directory. Requests in flight keep the pages they started with; if loading
+// fails the current ones stay in place.
+func (r *Router) ReloadTemplates() error {
+	pages, err := LoadPages(r.theme, assetsPrefix)
+	if err != nil {
+		return fmt.Errorf("reloading templates: %w", err)
+	}
+	r.pages.Store(pages)
+	return nil
+}
+
 func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	r.engine.ServeHTTP(w, req)
 }
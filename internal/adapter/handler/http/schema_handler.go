@@ -0,0 +1,14 @@
+package http
+
+import (
+	"net/http"
+	"path"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/sql/data"
+)
+
+// HandleSchema serves the published JSON Schema of a seed file, e.g. /schemas/skills.schema.json.
+func (r *Router) HandleSchema(c *gin.Context) {
+	c.FileFromFS(path.Join("schemas", path.Base(c.Param("file"))), http.FS(data.Schemas))
+}
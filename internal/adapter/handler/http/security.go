@@ -0,0 +1,89 @@
+package http
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+)
+
+// cspNoncePlaceholder is replaced by the request's nonce in the configured CSP.
+const cspNoncePlaceholder = "{nonce}"
+
+type cspNonceKey struct{}
+
+// withCSPNonce returns a copy of ctx carrying the CSP nonce of the request.
+func withCSPNonce(ctx context.Context, nonce string) context.Context {
+	return context.WithValue(ctx, cspNonceKey{}, nonce)
+}
+
+// cspNonce returns the CSP nonce of the request, or "" if the CSP has none.
+func cspNonce(ctx context.Context) string {
+	nonce, _ := ctx.Value(cspNonceKey{}).(string)
+	return nonce
+}
+
+// securityHeaders sets the configured security headers on every response. When the CSP asks
+// for a nonce, a fresh one is put in the request context for the templates to use.
+func securityHeaders(cfg config.SecurityConfig) gin.HandlerFunc {
+	hsts := ""
+	if cfg.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.FormatInt(int64(cfg.HSTSMaxAge.Seconds()), 10)
+		if cfg.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+	static := map[string]string{
+		"Strict-Transport-Security": hsts,
+		"X-Frame-Options":           cfg.FrameOptions,
+		"Referrer-Policy":           cfg.ReferrerPolicy,
+		"Permissions-Policy":        cfg.PermissionsPolicy,
+		"X-Content-Type-Options":    "nosniff",
+	}
+	useNonce := strings.Contains(cfg.CSP, cspNoncePlaceholder)
+
+	return func(c *gin.Context) {
+		h := c.Writer.Header()
+		for name, value := range static {
+			if value != "" {
+				h.Set(name, value)
+			}
+		}
+		if cfg.CSP != "" {
+			csp := cfg.CSP
+			if useNonce {
+				nonce := newCSPNonce()
+				c.Request = c.Request.WithContext(withCSPNonce(c.Request.Context(), nonce))
+				csp = strings.ReplaceAll(csp, cspNoncePlaceholder, nonce)
+			}
+			h.Set("Content-Security-Policy", csp)
+		}
+		c.Next()
+	}
+}
+
+func newCSPNonce() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// limitBody rejects requests whose body is larger than max bytes with 413. A body without a
+// declared length is cut off at max, failing the handler's read.
+func limitBody(max int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > max {
+			abortWithError(c, http.StatusRequestEntityTooLarge, "request body too large", nil)
+			return
+		}
+		if c.Request.Body != nil {
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, max)
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/metrics"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		cfg      config.SecurityConfig
+		expected map[string]string
+	}{
+		{
+			name: "all headers",
+			cfg: config.SecurityConfig{
+				CSP:                   "default-src 'self'",
+				HSTSMaxAge:            365 * 24 * time.Hour,
+				HSTSIncludeSubdomains: true,
+				FrameOptions:          "DENY",
+				ReferrerPolicy:        "no-referrer",
+				PermissionsPolicy:     "camera=()",
+			},
+			expected: map[string]string{
+				"Content-Security-Policy":   "default-src 'self'",
+				"Strict-Transport-Security": "max-age=31536000; includeSubDomains",
+				"X-Frame-Options":           "DENY",
+				"Referrer-Policy":           "no-referrer",
+				"Permissions-Policy":        "camera=()",
+				"X-Content-Type-Options":    "nosniff",
+			},
+		},
+		{
+			name: "disabled headers",
+			cfg:  config.SecurityConfig{},
+			expected: map[string]string{
+				"Content-Security-Policy":   "",
+				"Strict-Transport-Security": "",
+				"X-Frame-Options":           "",
+				"X-Content-Type-Options":    "nosniff",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gin.New()
+			g.Use(securityHeaders(tt.cfg))
+			g.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			for name, value := range tt.expected {
+				assert.Equal(t, value, w.Header().Get(name), name)
+			}
+		})
+	}
+}
+
+func TestSecurityHeadersNonce(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := &Router{cacheControl: "public, no-cache"}
+	g := gin.New()
+	g.Use(securityHeaders(config.SecurityConfig{CSP: "script-src 'nonce-{nonce}'"}))
+	// A theme with an inline script puts the nonce on it.
+	g.GET("/", func(c *gin.Context) {
+		body := `<script nonce="` + cspNonce(c.Request.Context()) + `">run()</script>`
+		r.writeConditional(c, "text/html; charset=utf-8", time.Time{}, []byte(body))
+	})
+
+	get := func(etag string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		w := httptest.NewRecorder()
+		g.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("")
+	require.Equal(t, http.StatusOK, w.Code)
+	match := regexp.MustCompile(`^script-src 'nonce-([A-Za-z0-9_-]{22})'$`).FindStringSubmatch(w.Header().Get("Content-Security-Policy"))
+	require.NotNil(t, match)
+	assert.Contains(t, w.Body.String(), `nonce="`+match[1]+`"`)
+
+	// A revalidated page would keep its old nonce under the new policy, so it is never cached.
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	assert.Empty(t, w.Header().Get("ETag"))
+	second := get("*")
+	require.Equal(t, http.StatusOK, second.Code)
+	assert.NotEqual(t, w.Header().Get("Content-Security-Policy"), second.Header().Get("Content-Security-Policy"))
+}
+
+func TestHomeIsCacheableByDefault(t *testing.T) {
+	cfg, err := config.Parse()
+	require.NoError(t, err)
+	health := service.NewHealthService(time.Second)
+	health.MarkSeeded()
+	r := NewRouter(cfg, Services{CV: testCV(), Health: health, Metrics: metrics.New()})
+
+	get := func(etag string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		w := httptest.NewRecorder()
+		r.engine.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Content-Security-Policy"))
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Equal(t, cfg.Server.CacheControl, w.Header().Get("Cache-Control"))
+	assert.Equal(t, http.StatusNotModified, get(etag).Code)
+}
+
+func TestLimitBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	g := gin.New()
+	g.Use(limitBody(8))
+	g.POST("/", func(c *gin.Context) {
+		if _, err := io.ReadAll(c.Request.Body); err != nil {
+			abortWithError(c, http.StatusRequestEntityTooLarge, "", err)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name     string
+		body     string
+		chunked  bool
+		expected int
+	}{
+		{"within limit", "12345678", false, http.StatusOK},
+		{"declared too large", "123456789", false, http.StatusRequestEntityTooLarge},
+		{"undeclared too large", "123456789", true, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, req)
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
@@ -2,39 +2,99 @@ package http
 
 import (
 	"context"
-	"github.com/guillermoBallester/go-platform-cv/internal/config"
-	"log"
+	"crypto/tls"
+	"log/slog"
+	"net"
 	"net/http"
 	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
 )
 
 // Server represents an HTTP server instance with read, write, and idle timeouts
 type Server struct {
-	httpServer *http.Server
+	httpServer      *http.Server
+	shutdownTimeout time.Duration
+}
+
+// newHTTPServer creates an http.Server with the configured timeouts and header limit.
+func newHTTPServer(cfg config.ServerConfig, addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+	}
+}
+
+// NewServer creates a new server instance with the provided configuration and request handler.
+// With a TLS configuration it serves HTTPS, negotiating HTTP/2.
+func NewServer(cfg *config.Config, handler http.Handler, tlsConfig *tls.Config) *Server {
+	srv := newHTTPServer(cfg.Server, cfg.Server.Address(), handler)
+	srv.TLSConfig = tlsConfig
+	srv.Protocols = new(http.Protocols)
+	srv.Protocols.SetHTTP1(true)
+	srv.Protocols.SetHTTP2(true)
+	return &Server{httpServer: srv, shutdownTimeout: cfg.Server.ShutdownTimeout}
 }
 
-// NewServer creates a new server instance with the provided configuration and request handler
-func NewServer(cfg *config.Config, handler http.Handler) *Server {
+// NewRedirectServer creates a plain HTTP server on addr running handler, typically
+// RedirectToHTTPS wrapped to answer ACME challenges.
+func NewRedirectServer(cfg *config.Config, addr string, handler http.Handler) *Server {
 	return &Server{
-		httpServer: &http.Server{
-			Addr:         cfg.Server.Address(),
-			Handler:      handler,
-			ReadTimeout:  cfg.Server.ReadTimeout,
-			WriteTimeout: cfg.Server.WriteTimeout,
-			IdleTimeout:  120 * time.Second,
-		},
+		httpServer:      newHTTPServer(cfg.Server, addr, handler),
+		shutdownTimeout: cfg.Server.ShutdownTimeout,
+	}
+}
+
+// RedirectToHTTPS redirects every request to the same URL over HTTPS on httpsPort. GET and
+// HEAD are redirected permanently with 301; other methods with 308, which keeps the method.
+func RedirectToHTTPS(httpsPort string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(r.Host); err == nil {
+			host = h
+		}
+		if httpsPort != "443" {
+			host = net.JoinHostPort(host, httpsPort)
+		}
+		code := http.StatusPermanentRedirect
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			code = http.StatusMovedPermanently
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), code)
+	})
+}
+
+// NewMetricsServer creates a server exposing only the metrics handler on the separate
+// metrics address, so it can be firewalled off from the public port.
+func NewMetricsServer(cfg *config.Config, metricsHandler http.Handler) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metricsHandler)
+	return &Server{
+		httpServer:      newHTTPServer(cfg.Server, cfg.Metrics.Addr, mux),
+		shutdownTimeout: cfg.Server.ShutdownTimeout,
 	}
 }
 
 // Run starts the server and listens for incoming requests on the specified address
 func (s *Server) Run() error {
-	log.Printf("Server running on %s", s.httpServer.Addr)
+	if s.httpServer.TLSConfig != nil {
+		slog.Info("server listening", "addr", s.httpServer.Addr, "tls", true)
+		// The certificates come from TLSConfig.GetCertificate.
+		return s.httpServer.ListenAndServeTLS("", "")
+	}
+	slog.Info("server listening", "addr", s.httpServer.Addr)
 	return s.httpServer.ListenAndServe()
 }
 
-// Shutdown gracefully shuts down the server with a specified context and timeout of 5 seconds
+// Shutdown stops accepting connections and waits for in-flight requests to complete, for at
+// most the configured shutdown timeout.
 func (s *Server) Shutdown(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, s.shutdownTimeout)
 	defer cancel()
 
 	return s.httpServer.Shutdown(ctx)
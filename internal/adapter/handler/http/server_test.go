@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServersUseConfiguredLimits(t *testing.T) {
+	cfg := &config.Config{
+		Server: config.ServerConfig{
+			Port:              "8080",
+			ReadTimeout:       1 * time.Second,
+			ReadHeaderTimeout: 2 * time.Second,
+			WriteTimeout:      3 * time.Second,
+			IdleTimeout:       4 * time.Second,
+			MaxHeaderBytes:    4096,
+			ShutdownTimeout:   5 * time.Second,
+		},
+		Metrics: config.MetricsConfig{Addr: ":9090"},
+	}
+
+	servers := map[string]*Server{
+		"main":     NewServer(cfg, http.NotFoundHandler(), nil),
+		"metrics":  NewMetricsServer(cfg, http.NotFoundHandler()),
+		"redirect": NewRedirectServer(cfg, ":8081", http.NotFoundHandler()),
+	}
+	for name, s := range servers {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, 1*time.Second, s.httpServer.ReadTimeout)
+			assert.Equal(t, 2*time.Second, s.httpServer.ReadHeaderTimeout)
+			assert.Equal(t, 3*time.Second, s.httpServer.WriteTimeout)
+			assert.Equal(t, 4*time.Second, s.httpServer.IdleTimeout)
+			assert.Equal(t, 4096, s.httpServer.MaxHeaderBytes)
+			assert.Equal(t, 5*time.Second, s.shutdownTimeout)
+		})
+	}
+}
+
+func TestRedirectToHTTPS(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		target    string
+		httpsPort string
+		expected  int
+		location  string
+	}{
+		{"default port", http.MethodGet, "http://cv.example.com/export/pdf?x=1", "443", http.StatusMovedPermanently, "https://cv.example.com/export/pdf?x=1"},
+		{"strips http port", http.MethodHead, "http://cv.example.com:80/", "443", http.StatusMovedPermanently, "https://cv.example.com/"},
+		{"custom https port", http.MethodGet, "http://cv.example.com:8080/", "8443", http.StatusMovedPermanently, "https://cv.example.com:8443/"},
+		{"keeps method", http.MethodPost, "http://cv.example.com/api/cv", "443", http.StatusPermanentRedirect, "https://cv.example.com/api/cv"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			RedirectToHTTPS(tt.httpsPort).ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, nil))
+			assert.Equal(t, tt.expected, w.Code)
+			assert.Equal(t, tt.location, w.Header().Get("Location"))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/export"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/memory"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+)
+
+// SnapshotReader reads back CV snapshots, named by version or label.
+type SnapshotReader interface {
+	Get(ctx context.Context, ref string) (domain.Snapshot, error)
+}
+
+// HandleSnapshot renders the home page as it was when a snapshot was taken, e.g. /v/3 or
+// /v/acme-2026-10, in the theme named by the theme query parameter if any. Snapshots are
+// only served to admins.
+func (r *Router) HandleSnapshot(c *gin.Context) {
+	snapshot, ok := r.loadSnapshot(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	page, err := r.pages.Load().Home(ctx, service.NewCVService(memory.NewStore(snapshot.CV)), c.Query("theme"))
+	if err != nil {
+		abortWithError(c, http.StatusInternalServerError, "", err)
+		return
+	}
+	r.writeConditional(c, "text/html; charset=utf-8", page.Modified, page.Body)
+}
+
+// HandleSnapshotExport serves a snapshot as a downloadable document, e.g. /v/3/export/pdf.
+func (r *Router) HandleSnapshotExport(c *gin.Context) {
+	format := c.Param("format")
+	if !slices.Contains(export.Formats, format) {
+		abortWithError(c, http.StatusNotFound, "unknown export format", nil)
+		return
+	}
+	snapshot, ok := r.loadSnapshot(c)
+	if !ok {
+		return
+	}
+	r.writeDocument(c, snapshot.CV, format, "cv-"+c.Param("snapshot")+"."+format)
+}
+
+// loadSnapshot loads the snapshot named in the path, or aborts the request.
+func (r *Router) loadSnapshot(c *gin.Context) (domain.Snapshot, bool) {
+	snapshot, err := r.snapshots.Get(c.Request.Context(), c.Param("snapshot"))
+	if errors.Is(err, domain.ErrSnapshotNotFound) {
+		abortWithError(c, http.StatusNotFound, "unknown snapshot", nil)
+		return domain.Snapshot{}, false
+	}
+	if err != nil {
+		abortWithError(c, http.StatusInternalServerError, "", err)
+		return domain.Snapshot{}, false
+	}
+	return snapshot, true
+}
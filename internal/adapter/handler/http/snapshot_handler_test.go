@@ -0,0 +1,78 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSnapshots holds snapshots by version and by label.
+type fakeSnapshots map[string]domain.Snapshot
+
+func (f fakeSnapshots) Get(_ context.Context, ref string) (domain.Snapshot, error) {
+	snapshot, ok := f[ref]
+	if !ok {
+		return domain.Snapshot{}, domain.ErrSnapshotNotFound
+	}
+	return snapshot, nil
+}
+
+func TestHandleSnapshot(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	pages, err := LoadPages(config.ThemeConfig{Name: "default"}, assetsPrefix)
+	require.NoError(t, err)
+
+	snapshot := domain.Snapshot{
+		SnapshotInfo: domain.SnapshotInfo{Version: 1, Label: "acme", CreatedAt: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		CV: domain.CV{Experiences: []domain.Experience{{
+			CompanyName: "Initech",
+			JobTitle:    "Engineer",
+			StartDate:   time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+		}}},
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		expected    int
+		contentType string
+		disposition string
+	}{
+		{"by version", "/v/1", http.StatusOK, "text/html", ""},
+		{"by label", "/v/acme", http.StatusOK, "text/html", ""},
+		{"unknown snapshot", "/v/2", http.StatusNotFound, "", ""},
+		{"export", "/v/acme/export/json", http.StatusOK, "application/json", `attachment; filename="cv-acme.json"`},
+		{"unknown format", "/v/1/export/doc", http.StatusNotFound, "", ""},
+		{"export of unknown snapshot", "/v/2/export/json", http.StatusNotFound, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Router{snapshots: fakeSnapshots{"1": snapshot, "acme": snapshot}, cacheControl: "public, no-cache"}
+			r.pages.Store(pages)
+			g := gin.New()
+			v := g.Group("/v", privateResponses())
+			v.GET("/:snapshot", r.HandleSnapshot)
+			v.GET("/:snapshot/export/:format", r.HandleSnapshotExport)
+
+			w := httptest.NewRecorder()
+			g.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			require.Equal(t, tt.expected, w.Code)
+			if tt.expected != http.StatusOK {
+				return
+			}
+			assert.Contains(t, w.Header().Get("Content-Type"), tt.contentType)
+			assert.Equal(t, tt.disposition, w.Header().Get("Content-Disposition"))
+			assert.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+			assert.Contains(t, w.Body.String(), "Initech")
+		})
+	}
+}
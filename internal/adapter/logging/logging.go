@@ -0,0 +1,70 @@
+// Package logging configures structured logging with log/slog and carries the request ID
+// through contexts, so every log line written while handling a request can be correlated.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID carried by ctx, or "" if there is none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// New creates a logger writing JSON lines in production, for the log pipeline, and readable
+// text in development.
+func New(cfg config.AppConfig, w io.Writer) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: cfg.LogLevel}
+	var h slog.Handler
+	if cfg.IsDevelopment() {
+		h = slog.NewTextHandler(w, opts)
+	} else {
+		h = slog.NewJSONHandler(w, opts)
+	}
+	return slog.New(contextHandler{Handler: h})
+}
+
+// Setup makes the logger for cfg the default, so slog's package functions and the standard
+// log package both write through it.
+func Setup(cfg config.AppConfig, w io.Writer) *slog.Logger {
+	logger := New(cfg, w)
+	slog.SetDefault(logger)
+	return logger
+}
+
+// contextHandler adds the request ID and the trace and span IDs found in the context of a
+// record, so the *Context logging functions correlate without passing them explicitly.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()), slog.String("span_id", sc.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithGroup(name)}
+}
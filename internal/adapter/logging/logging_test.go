@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		env      string
+		contains string
+	}{
+		{"production", `"request_id":"req-1"`},
+		{"development", "request_id=req-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := New(config.AppConfig{Env: tt.env, LogLevel: slog.LevelInfo}, &buf)
+
+			logger.InfoContext(WithRequestID(context.Background(), "req-1"), "hello", "key", "value")
+			assert.Contains(t, buf.String(), tt.contains)
+		})
+	}
+}
+
+func TestNewLevelAndAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(config.AppConfig{Env: "production", LogLevel: slog.LevelWarn}, &buf).With("component", "test")
+
+	logger.Info("dropped")
+	assert.Empty(t, buf.String())
+
+	logger.WarnContext(WithRequestID(context.Background(), "req-2"), "kept")
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	assert.Equal(t, "kept", line["msg"])
+	assert.Equal(t, "test", line["component"])
+	assert.Equal(t, "req-2", line["request_id"])
+}
+
+func TestRequestIDMissing(t *testing.T) {
+	assert.Empty(t, RequestID(context.Background()))
+}
@@ -0,0 +1,176 @@
+// Package metrics exposes Prometheus metrics for HTTP requests, database queries, the
+// connection pool and seed runs.
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "gocv"
+
+// Metrics holds the application metrics and the registry they are exported from.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+	dbQueries    *prometheus.HistogramVec
+	seedRuns     *prometheus.CounterVec
+	seedRecords  *prometheus.CounterVec
+	cacheLookups *prometheus.CounterVec
+	cacheFlushes prometheus.Counter
+}
+
+// New creates the application metrics on a dedicated registry, along with the Go runtime
+// and process collectors.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route template and status code.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method, route template and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		dbQueries: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "Database query latency by repository, query and outcome.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"repository", "query", "status"}),
+		seedRuns: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "seed_runs_total",
+			Help:      "Seed runs by outcome: success, problems, error or dry_run.",
+		}, []string{"outcome"}),
+		seedRecords: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "seed_records_total",
+			Help:      "Seed records processed by entity and result.",
+		}, []string{"entity", "result"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_lookups_total",
+			Help:      "CV cache lookups by key and result: hit or miss.",
+		}, []string{"key", "result"}),
+		cacheFlushes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_invalidations_total",
+			Help:      "CV cache invalidations, on startup, reconnects and content changes.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.dbQueries,
+		m.seedRuns,
+		m.seedRecords,
+		m.cacheLookups,
+		m.cacheFlushes,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// Middleware records the count and latency of every request, labelled by the route template
+// (e.g. /schemas/:file) rather than the raw path, so label cardinality stays bounded.
+func (m *Metrics) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		status := strconv.Itoa(c.Writer.Status())
+		m.httpRequests.WithLabelValues(c.Request.Method, route, status).Inc()
+		m.httpDuration.WithLabelValues(c.Request.Method, route, status).Observe(time.Since(start).Seconds())
+	}
+}
+
+// ObserveQuery records the latency of a repository query. A query finding no rows is not an error.
+func (m *Metrics) ObserveQuery(repository, query string, duration time.Duration, err error) {
+	status := "ok"
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		status = "error"
+	}
+	m.dbQueries.WithLabelValues(repository, query, status).Observe(duration.Seconds())
+}
+
+// ObserveSeedRun records the outcome of a seed run and the per-entity record counts of its report.
+func (m *Metrics) ObserveSeedRun(report *service.SeedReport, err error) {
+	outcome := "success"
+	switch {
+	case err != nil || report == nil:
+		outcome = "error"
+	case report.HasProblems():
+		outcome = "problems"
+	case report.DryRun:
+		outcome = "dry_run"
+	}
+	m.seedRuns.WithLabelValues(outcome).Inc()
+
+	if report == nil {
+		return
+	}
+	entities := []struct {
+		name   string
+		counts service.SeedCounts
+	}{
+		{"skills", report.Skills},
+		{"experiences", report.Experiences},
+		{"achievements", report.Achievements},
+		{"projects", report.Projects},
+	}
+	for _, e := range entities {
+		m.seedRecords.WithLabelValues(e.name, "created").Add(float64(e.counts.Created))
+		m.seedRecords.WithLabelValues(e.name, "updated").Add(float64(e.counts.Updated))
+		m.seedRecords.WithLabelValues(e.name, "unchanged").Add(float64(e.counts.Unchanged))
+		m.seedRecords.WithLabelValues(e.name, "deleted").Add(float64(e.counts.Deleted))
+		m.seedRecords.WithLabelValues(e.name, "failed").Add(float64(e.counts.Failed))
+	}
+}
+
+// ObserveCacheLookup records a CV cache hit or miss.
+func (m *Metrics) ObserveCacheLookup(key string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheLookups.WithLabelValues(key, result).Inc()
+}
+
+// ObserveCacheInvalidation records a CV cache invalidation.
+func (m *Metrics) ObserveCacheInvalidation() {
+	m.cacheFlushes.Inc()
+}
+
+// RegisterPool exports the statistics of a connection pool, labelled with its name, e.g.
+// "primary" or "replica".
+func (m *Metrics) RegisterPool(pool *pgxpool.Pool, name string) {
+	m.registry.MustRegister(newPoolCollector(pool, name))
+}
@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareLabelsRouteTemplate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	m := New()
+
+	g := gin.New()
+	g.Use(m.Middleware())
+	g.GET("/schemas/:file", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	for _, path := range []string{"/schemas/skills.schema.json", "/schemas/projects.schema.json", "/nope"} {
+		g.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("GET", "/schemas/:file", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("GET", "unmatched", "404")))
+}
+
+func TestObserveQuery(t *testing.T) {
+	m := New()
+	m.ObserveQuery("skills", "GetSkillByName", time.Millisecond, pgx.ErrNoRows)
+	m.ObserveQuery("skills", "GetSkillByName", time.Millisecond, errors.New("connection reset"))
+	m.ObserveQuery("skills", "ListSkills", time.Millisecond, nil)
+
+	assert.Equal(t, 3, testutil.CollectAndCount(m.dbQueries))
+	assert.Equal(t, uint64(1), sampleCount(t, m, "skills", "GetSkillByName", "ok"))
+	assert.Equal(t, uint64(1), sampleCount(t, m, "skills", "GetSkillByName", "error"))
+}
+
+func TestObserveSeedRun(t *testing.T) {
+	m := New()
+	m.ObserveSeedRun(&service.SeedReport{Skills: service.SeedCounts{Created: 3, Failed: 1}, Failures: []service.SeedFailure{{}}}, nil)
+	m.ObserveSeedRun(&service.SeedReport{DryRun: true}, nil)
+	m.ObserveSeedRun(nil, errors.New("begin seed transaction"))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.seedRuns.WithLabelValues("problems")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.seedRuns.WithLabelValues("dry_run")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.seedRuns.WithLabelValues("error")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(m.seedRecords.WithLabelValues("skills", "created")))
+}
+
+func TestObserveCache(t *testing.T) {
+	m := New()
+	m.ObserveCacheLookup("cv:skills", true)
+	m.ObserveCacheLookup("cv:skills", true)
+	m.ObserveCacheLookup("cv:skills", false)
+	m.ObserveCacheInvalidation()
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.cacheLookups.WithLabelValues("cv:skills", "hit")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.cacheLookups.WithLabelValues("cv:skills", "miss")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.cacheFlushes))
+}
+
+func TestHandlerExposesMetrics(t *testing.T) {
+	m := New()
+	m.ObserveSeedRun(&service.SeedReport{}, nil)
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.Contains(w.Body.String(), `gocv_seed_runs_total{outcome="success"} 1`))
+}
+
+func sampleCount(t *testing.T, m *Metrics, labels ...string) uint64 {
+	t.Helper()
+	metric := &dto.Metric{}
+	require.NoError(t, m.dbQueries.WithLabelValues(labels...).(prometheus.Histogram).Write(metric))
+	return metric.GetHistogram().GetSampleCount()
+}
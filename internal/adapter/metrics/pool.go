@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector reads pgxpool.Stat on every scrape.
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquired     *prometheus.Desc
+	idle         *prometheus.Desc
+	total        *prometheus.Desc
+	max          *prometheus.Desc
+	acquires     *prometheus.Desc
+	emptyAcquire *prometheus.Desc
+	acquireTime  *prometheus.Desc
+	waitTime     *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool, name string) *poolCollector {
+	labels := prometheus.Labels{"pool": name}
+	desc := func(metric, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", metric), help, nil, labels)
+	}
+	return &poolCollector{
+		pool:         pool,
+		acquired:     desc("acquired_conns", "Connections currently acquired from the pool."),
+		idle:         desc("idle_conns", "Idle connections in the pool."),
+		total:        desc("total_conns", "Connections in the pool, including those being established."),
+		max:          desc("max_conns", "Maximum size of the pool."),
+		acquires:     desc("acquires_total", "Successful connection acquisitions."),
+		emptyAcquire: desc("empty_acquires_total", "Acquisitions that had to wait because the pool was empty."),
+		acquireTime:  desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		waitTime:     desc("empty_acquire_wait_seconds_total", "Total time spent waiting for a connection because the pool was empty."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.acquired, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.max, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquire, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireTime, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.waitTime, prometheus.CounterValue, stat.EmptyAcquireWaitTime().Seconds())
+}
@@ -0,0 +1,192 @@
+package seedformat
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+)
+
+// positionFunc maps a JSON pointer to the line and column of the value in the source file.
+// It returns zeros if the pointer cannot be located.
+type positionFunc func(pointer string) (line, column int)
+
+type position struct {
+	line, column int
+}
+
+// lookup returns a positionFunc over a pointer index. A pointer that is not indexed falls back
+// to its closest indexed parent, so issues about missing keys point at the enclosing record.
+func lookup(index map[string]position) positionFunc {
+	return func(pointer string) (int, int) {
+		for {
+			if pos, ok := index[pointer]; ok {
+				return pos.line, pos.column
+			}
+			if pointer == "" {
+				return 0, 0
+			}
+			pointer = pointer[:strings.LastIndex(pointer, "/")]
+		}
+	}
+}
+
+func childPointer(parent, token string) string {
+	token = strings.ReplaceAll(token, "~", "~0")
+	token = strings.ReplaceAll(token, "/", "~1")
+	return parent + "/" + token
+}
+
+// offsetPosition converts a byte offset into a 1-based line and column.
+func offsetPosition(content []byte, offset int) (line, column int) {
+	offset = min(max(offset, 0), len(content))
+	lead := content[:offset]
+	line = bytes.Count(lead, []byte{'\n'}) + 1
+	column = offset - bytes.LastIndexByte(lead, '\n')
+	return line, column
+}
+
+// jsonPositions indexes every value of a JSON document, using the key position for object members.
+func jsonPositions(content []byte) positionFunc {
+	index := make(map[string]position)
+	dec := json.NewDecoder(bytes.NewReader(content))
+
+	// next returns the position of the next token, skipping separators the decoder has not consumed.
+	next := func() position {
+		offset := int(dec.InputOffset())
+		for offset < len(content) && strings.IndexByte(" \t\r\n,:", content[offset]) >= 0 {
+			offset++
+		}
+		line, column := offsetPosition(content, offset)
+		return position{line, column}
+	}
+
+	var walk func(pointer string, pos position) error
+	walk = func(pointer string, pos position) error {
+		index[pointer] = pos
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		delim, ok := tok.(json.Delim)
+		if !ok {
+			return nil
+		}
+		switch delim {
+		case '[':
+			for i := 0; dec.More(); i++ {
+				if err := walk(childPointer(pointer, strconv.Itoa(i)), next()); err != nil {
+					return err
+				}
+			}
+		case '{':
+			for dec.More() {
+				keyPos := next()
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				name, _ := key.(string)
+				if err := walk(childPointer(pointer, name), keyPos); err != nil {
+					return err
+				}
+			}
+		}
+		_, err = dec.Token()
+		return err
+	}
+
+	// The document has already been parsed successfully, so a walk error can only leave the
+	// index incomplete; lookups then fall back to the closest parent.
+	_ = walk("", next())
+	return lookup(index)
+}
+
+// yamlPositions indexes every node of a YAML document, using the key position for mapping values.
+func yamlPositions(content []byte) positionFunc {
+	index := make(map[string]position)
+	file, err := parser.ParseBytes(content, 0)
+	if err == nil && len(file.Docs) > 0 {
+		walkYAML(file.Docs[0].Body, "", index)
+	}
+	return lookup(index)
+}
+
+func walkYAML(node ast.Node, pointer string, index map[string]position) {
+	if node == nil {
+		return
+	}
+	if tk := node.GetToken(); tk != nil {
+		index[pointer] = position{tk.Position.Line, tk.Position.Column}
+	}
+
+	switch n := node.(type) {
+	case *ast.SequenceNode:
+		for i, value := range n.Values {
+			walkYAML(value, childPointer(pointer, strconv.Itoa(i)), index)
+		}
+	case *ast.MappingNode:
+		for _, value := range n.Values {
+			walkYAMLMappingValue(value, pointer, index)
+		}
+		if len(n.Values) > 0 {
+			index[pointer] = index[firstKey(n.Values[0], pointer)]
+		}
+	case *ast.MappingValueNode:
+		walkYAMLMappingValue(n, pointer, index)
+		index[pointer] = index[firstKey(n, pointer)]
+	}
+}
+
+// firstKey returns the pointer of a mapping's first key. A mapping's own token is the ':' of
+// that key, so its key position is used for the mapping instead.
+func firstKey(n *ast.MappingValueNode, pointer string) string {
+	if tk := n.Key.GetToken(); tk != nil {
+		return childPointer(pointer, tk.Value)
+	}
+	return pointer
+}
+
+func walkYAMLMappingValue(n *ast.MappingValueNode, pointer string, index map[string]position) {
+	keyToken := n.Key.GetToken()
+	if keyToken == nil {
+		return
+	}
+	child := childPointer(pointer, keyToken.Value)
+	walkYAML(n.Value, child, index)
+	index[child] = position{keyToken.Position.Line, keyToken.Position.Column}
+}
+
+// tomlPositions indexes the records of a TOML seed file by scanning for its [[entity]] headers
+// and the keys that follow each of them. Seed records are flat tables, so this is enough to
+// locate every top-level field.
+func tomlPositions(content []byte, entity string) positionFunc {
+	header := regexp.MustCompile(`^\s*\[\[\s*"?` + regexp.QuoteMeta(entity) + `"?\s*\]\]`)
+	otherHeader := regexp.MustCompile(`^\s*\[`)
+	keyLine := regexp.MustCompile(`^(\s*)"?([A-Za-z0-9_-]+)"?\s*=`)
+
+	index := map[string]position{"": {1, 1}}
+	record := -1
+	inRecord := false
+	for i, line := range strings.Split(string(content), "\n") {
+		switch {
+		case header.MatchString(line):
+			record++
+			inRecord = true
+			indent := len(line) - len(strings.TrimLeft(line, " \t"))
+			index[childPointer("", strconv.Itoa(record))] = position{i + 1, indent + 1}
+		case otherHeader.MatchString(line):
+			inRecord = false
+		case inRecord:
+			if m := keyLine.FindStringSubmatch(line); m != nil {
+				pointer := childPointer(childPointer("", strconv.Itoa(record)), m[2])
+				index[pointer] = position{i + 1, len(m[1]) + 1}
+			}
+		}
+	}
+	return lookup(index)
+}
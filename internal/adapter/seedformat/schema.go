@@ -0,0 +1,107 @@
+package seedformat
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"sync"
+
+	"github.com/guillermoBallester/go-platform-cv/sql/data"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+var (
+	schemasOnce sync.Once
+	schemas     map[string]*jsonschema.Schema
+	schemasErr  error
+)
+
+// loadSchemas compiles the published seed schemas, keyed by entity name.
+func loadSchemas() (map[string]*jsonschema.Schema, error) {
+	schemasOnce.Do(func() {
+		files, err := fs.Glob(data.Schemas, "schemas/*.schema.json")
+		if err != nil {
+			schemasErr = err
+			return
+		}
+
+		compiler := jsonschema.NewCompiler()
+		compiler.AssertFormat()
+
+		urls := make(map[string]string, len(files))
+		for _, file := range files {
+			content, err := fs.ReadFile(data.Schemas, file)
+			if err != nil {
+				schemasErr = err
+				return
+			}
+			doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(content))
+			if err != nil {
+				schemasErr = fmt.Errorf("parsing %s: %w", file, err)
+				return
+			}
+			url := "file:///" + file
+			if err := compiler.AddResource(url, doc); err != nil {
+				schemasErr = fmt.Errorf("loading %s: %w", file, err)
+				return
+			}
+			urls[entityOf(file)] = url
+		}
+
+		schemas = make(map[string]*jsonschema.Schema, len(urls))
+		for entity, url := range urls {
+			sch, err := compiler.Compile(url)
+			if err != nil {
+				schemasErr = fmt.Errorf("compiling %s schema: %w", entity, err)
+				return
+			}
+			schemas[entity] = sch
+		}
+	})
+	return schemas, schemasErr
+}
+
+// entityOf returns the entity name of a schema file, e.g. "skills" for schemas/skills.schema.json.
+func entityOf(file string) string {
+	name := path.Base(file)
+	return name[:len(name)-len(".schema.json")]
+}
+
+// validate checks JSON-encoded records against the entity's schema.
+// The returned issues carry a JSON pointer but no file position.
+func validate(entity string, normalized []byte) []Issue {
+	all, err := loadSchemas()
+	if err != nil {
+		return []Issue{{Message: err.Error()}}
+	}
+	sch, ok := all[entity]
+	if !ok {
+		return []Issue{{Message: fmt.Sprintf("no schema published for %q", entity)}}
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(normalized))
+	if err != nil {
+		return []Issue{{Message: err.Error()}}
+	}
+
+	err = sch.Validate(instance)
+	if err == nil {
+		return nil
+	}
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return []Issue{{Message: err.Error()}}
+	}
+
+	var issues []Issue
+	for _, unit := range validationErr.BasicOutput().Errors {
+		if unit.Error == nil {
+			continue
+		}
+		issues = append(issues, Issue{Path: unit.InstanceLocation, Message: unit.Error.String()})
+	}
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Path < issues[j].Path })
+	return issues
+}
@@ -0,0 +1,154 @@
+package seedformat
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Extensions lists the supported seed file extensions, in lookup order.
+var Extensions = []string{".json", ".yaml", ".yml", ".toml"}
+
+// ErrUnsupportedFormat is returned for a seed file whose extension is not in Extensions.
+var ErrUnsupportedFormat = errors.New("unsupported seed file format")
+
+// Issue describes a problem found in a seed file, located by line and column where known.
+type Issue struct {
+	File    string
+	Line    int
+	Column  int
+	Path    string // JSON pointer of the offending value, e.g. /2/start_date
+	Message string
+}
+
+// Location returns the issue position as "file:line:column".
+func (i Issue) Location() string {
+	if i.Line == 0 {
+		return i.File
+	}
+	return fmt.Sprintf("%s:%d:%d", i.File, i.Line, i.Column)
+}
+
+func (i Issue) String() string {
+	if i.Path == "" {
+		return i.Location() + ": " + i.Message
+	}
+	return i.Location() + ": " + i.Path + ": " + i.Message
+}
+
+// ValidationError holds every issue found in a seed file.
+type ValidationError struct {
+	Issues []Issue
+}
+
+func (e *ValidationError) Error() string {
+	lines := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		lines[i] = issue.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Decode parses a seed file in the format given by its extension, validates it against
+// the JSON Schema of the named entity (e.g. "skills") and returns the records encoded as JSON.
+// Syntax and schema problems are returned as a *ValidationError.
+func Decode(entity, file string, content []byte) ([]byte, error) {
+	var (
+		value     any
+		positions positionFunc
+		err       error
+	)
+
+	switch ext := strings.ToLower(path.Ext(file)); ext {
+	case ".json":
+		value, positions, err = decodeJSON(file, content)
+	case ".yaml", ".yml":
+		value, positions, err = decodeYAML(file, content)
+	case ".toml":
+		value, positions, err = decodeTOML(entity, file, content)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("encoding %s: %w", file, err)
+	}
+
+	if issues := validate(entity, normalized); len(issues) > 0 {
+		for i := range issues {
+			issues[i].File = file
+			issues[i].Line, issues[i].Column = positions(issues[i].Path)
+		}
+		return nil, &ValidationError{Issues: issues}
+	}
+
+	return normalized, nil
+}
+
+func decodeJSON(file string, content []byte) (any, positionFunc, error) {
+	var value any
+	if err := json.Unmarshal(content, &value); err != nil {
+		issue := Issue{File: file, Message: err.Error()}
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			issue.Line, issue.Column = offsetPosition(content, int(syntaxErr.Offset))
+		}
+		return nil, nil, &ValidationError{Issues: []Issue{issue}}
+	}
+	return value, jsonPositions(content), nil
+}
+
+func decodeYAML(file string, content []byte) (any, positionFunc, error) {
+	var value any
+	if err := yaml.Unmarshal(content, &value); err != nil {
+		issue := Issue{File: file, Message: err.Error()}
+		var yamlErr yaml.Error
+		if errors.As(err, &yamlErr) && yamlErr.GetToken() != nil {
+			issue.Message = yamlErr.GetMessage()
+			issue.Line = yamlErr.GetToken().Position.Line
+			issue.Column = yamlErr.GetToken().Position.Column
+		}
+		return nil, nil, &ValidationError{Issues: []Issue{issue}}
+	}
+	return value, yamlPositions(content), nil
+}
+
+// decodeTOML reads the records of a TOML seed file, which are written as an array of
+// tables named after the entity, e.g. [[skills]].
+func decodeTOML(entity, file string, content []byte) (any, positionFunc, error) {
+	var root map[string]any
+	if err := toml.NewDecoder(bytes.NewReader(content)).Decode(&root); err != nil {
+		issue := Issue{File: file, Message: err.Error()}
+		var decodeErr *toml.DecodeError
+		if errors.As(err, &decodeErr) {
+			issue.Line, issue.Column = decodeErr.Position()
+		}
+		return nil, nil, &ValidationError{Issues: []Issue{issue}}
+	}
+
+	var issues []Issue
+	for key := range root {
+		if key != entity {
+			issues = append(issues, Issue{File: file, Message: fmt.Sprintf("unexpected top-level key %q, records must be [[%s]] tables", key, entity)})
+		}
+	}
+	if len(issues) > 0 {
+		return nil, nil, &ValidationError{Issues: issues}
+	}
+
+	records, ok := root[entity]
+	if !ok {
+		records = []any{}
+	}
+	return records, tomlPositions(content, entity), nil
+}
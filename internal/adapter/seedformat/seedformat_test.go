@@ -0,0 +1,147 @@
+package seedformat
+
+import (
+	"encoding/json"
+	"io/fs"
+	"testing"
+
+	"github.com/guillermoBallester/go-platform-cv/sql/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const experiencesYAML = `- company_name: Acme
+  job_title: Engineer
+  start_date: 2022-03-01
+  description: |
+    Built "things".
+    Over several lines.
+  skills: [Go]
+`
+
+const experiencesTOML = `[[experiences]]
+company_name = "Acme"
+job_title = "Engineer"
+start_date = 2022-03-01
+description = """
+Built "things".
+Over several lines.
+"""
+skills = ["Go"]
+`
+
+func TestDecode_Formats(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{"json", "experiences.json", `[{"company_name": "Acme", "job_title": "Engineer", "start_date": "2022-03-01",
+			"description": "Built \"things\".\nOver several lines.\n", "skills": ["Go"]}]`},
+		{"yaml", "experiences.yaml", experiencesYAML},
+		{"yml", "experiences.yml", experiencesYAML},
+		{"toml", "experiences.toml", experiencesTOML},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, err := Decode("experiences", tt.file, []byte(tt.content))
+			require.NoError(t, err)
+
+			var records []map[string]any
+			require.NoError(t, json.Unmarshal(normalized, &records))
+			require.Len(t, records, 1)
+			assert.Equal(t, "Acme", records[0]["company_name"])
+			assert.Equal(t, "2022-03-01", records[0]["start_date"])
+			assert.Equal(t, "Built \"things\".\nOver several lines.\n", records[0]["description"])
+			assert.Equal(t, []any{"Go"}, records[0]["skills"])
+		})
+	}
+}
+
+func TestDecode_Issues(t *testing.T) {
+	tests := []struct {
+		name       string
+		entity     string
+		file       string
+		content    string
+		wantPath   string
+		wantLine   int
+		wantColumn int
+	}{
+		{
+			name:     "json syntax error",
+			entity:   "skills",
+			file:     "skills.json",
+			content:  "[\n  {\"name\": \"Go\",}\n]",
+			wantLine: 2, wantColumn: 18,
+		},
+		{
+			name:     "json wrong type",
+			entity:   "skills",
+			file:     "skills.json",
+			content:  "[\n  {\"name\": \"Go\", \"category\": \"Backend\"},\n  {\"name\": \"SQL\", \"category\": \"Database\", \"proficiency\": \"high\"}\n]",
+			wantPath: "/1/proficiency", wantLine: 3, wantColumn: 43,
+		},
+		{
+			name:     "yaml missing required field",
+			entity:   "skills",
+			file:     "skills.yaml",
+			content:  "- name: Go\n  category: Backend\n- name: SQL\n",
+			wantPath: "/1", wantLine: 3, wantColumn: 3,
+		},
+		{
+			name:     "yaml invalid date",
+			entity:   "experiences",
+			file:     "experiences.yaml",
+			content:  "- company_name: Acme\n  job_title: Engineer\n  start_date: 2022-13-01\n  description: Work\n",
+			wantPath: "/0/start_date", wantLine: 3, wantColumn: 3,
+		},
+		{
+			name:     "toml unknown field",
+			entity:   "projects",
+			file:     "projects.toml",
+			content:  "[[projects]]\nname = \"CV\"\ndescription = \"Site\"\n\n[[projects]]\nname = \"API\"\ndescription = \"Gateway\"\n  colour = \"blue\"\n",
+			wantPath: "/1", wantLine: 5, wantColumn: 1,
+		},
+		{
+			name:     "toml out of range value",
+			entity:   "skills",
+			file:     "skills.toml",
+			content:  "[[skills]]\nname = \"Go\"\ncategory = \"Backend\"\nproficiency = 120\n",
+			wantPath: "/0/proficiency", wantLine: 4, wantColumn: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.entity, tt.file, []byte(tt.content))
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			require.NotEmpty(t, validationErr.Issues)
+			issue := validationErr.Issues[0]
+			assert.Equal(t, tt.file, issue.File)
+			assert.Equal(t, tt.wantPath, issue.Path)
+			assert.Equal(t, tt.wantLine, issue.Line, issue.String())
+			assert.Equal(t, tt.wantColumn, issue.Column, issue.String())
+		})
+	}
+}
+
+func TestDecode_UnsupportedFormat(t *testing.T) {
+	_, err := Decode("skills", "skills.xml", []byte("<skills/>"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestDecode_EmbeddedSeeds(t *testing.T) {
+	for _, entity := range []string{"skills", "experiences", "achievements", "projects"} {
+		t.Run(entity, func(t *testing.T) {
+			content, err := fs.ReadFile(data.FS, entity+".json")
+			require.NoError(t, err)
+
+			_, err = Decode(entity, entity+".json", content)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package seedsource
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"sync"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+)
+
+// ErrChecksumMismatch is returned when a seed file does not match its manifest entry.
+var ErrChecksumMismatch = errors.New("seed file checksum mismatch")
+
+// ChecksumSource verifies every file read from the wrapped source against a
+// sha256sum-style manifest ("<hex digest>  <file name>" per line) read from the same source.
+type ChecksumSource struct {
+	src      port.SeedSource
+	manifest string
+	required bool
+
+	once   sync.Once
+	sums   map[string]string
+	err    error
+	absent bool
+}
+
+// WithChecksums wraps src so that files are verified against the named manifest.
+// If required is false and the manifest does not exist, files are returned unverified.
+func WithChecksums(src port.SeedSource, manifest string, required bool) *ChecksumSource {
+	return &ChecksumSource{src: src, manifest: manifest, required: required}
+}
+
+// ReadFile returns the named seed file once its checksum has been verified.
+func (s *ChecksumSource) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	s.once.Do(func() { s.loadManifest(ctx) })
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	content, err := s.src.ReadFile(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if s.absent {
+		return content, nil
+	}
+
+	want, ok := s.sums[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not listed in %s", ErrChecksumMismatch, name, s.manifest)
+	}
+	sum := sha256.Sum256(content)
+	if got := hex.EncodeToString(sum[:]); got != want {
+		return nil, fmt.Errorf("%w: %s has sha256 %s, manifest expects %s", ErrChecksumMismatch, name, got, want)
+	}
+	return content, nil
+}
+
+func (s *ChecksumSource) loadManifest(ctx context.Context) {
+	content, err := s.src.ReadFile(ctx, s.manifest)
+	if errors.Is(err, fs.ErrNotExist) && !s.required {
+		s.absent = true
+		return
+	}
+	if err != nil {
+		s.err = fmt.Errorf("reading checksum manifest: %w", err)
+		return
+	}
+	s.sums, s.err = parseManifest(content)
+}
+
+// parseManifest parses sha256sum output, accepting both text ("  ") and binary (" *") mode lines.
+func parseManifest(content []byte) (map[string]string, error) {
+	sums := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		digest, name, ok := strings.Cut(text, " ")
+		if !ok || len(digest) != sha256.Size*2 {
+			return nil, fmt.Errorf("checksum manifest line %d: malformed entry", line)
+		}
+		name = strings.TrimPrefix(strings.TrimLeft(name, " "), "*")
+		sums[name] = strings.ToLower(digest)
+	}
+	return sums, scanner.Err()
+}
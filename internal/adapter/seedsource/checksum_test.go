@@ -0,0 +1,102 @@
+package seedsource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+func TestChecksumSource_ReadFile(t *testing.T) {
+	const skills = `[{"name": "Go"}]`
+
+	tests := []struct {
+		name     string
+		files    map[string]string
+		required bool
+		wantErr  error
+	}{
+		{
+			name:  "matching checksum",
+			files: map[string]string{"skills.json": skills, "SHA256SUMS": sha256Hex(skills) + "  skills.json\n"},
+		},
+		{
+			name:  "binary mode manifest entry",
+			files: map[string]string{"skills.json": skills, "SHA256SUMS": sha256Hex(skills) + " *skills.json\n"},
+		},
+		{
+			name:    "mismatching checksum",
+			files:   map[string]string{"skills.json": skills, "SHA256SUMS": sha256Hex("tampered") + "  skills.json\n"},
+			wantErr: ErrChecksumMismatch,
+		},
+		{
+			name:    "file not listed in manifest",
+			files:   map[string]string{"skills.json": skills, "SHA256SUMS": sha256Hex(skills) + "  other.json\n"},
+			wantErr: ErrChecksumMismatch,
+		},
+		{
+			name:  "manifest absent and optional",
+			files: map[string]string{"skills.json": skills},
+		},
+		{
+			name:     "manifest absent and required",
+			files:    map[string]string{"skills.json": skills},
+			required: true,
+			wantErr:  fs.ErrNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := NewDir(writeFiles(t, tt.files))
+			require.NoError(t, err)
+
+			content, err := WithChecksums(dir, "SHA256SUMS", tt.required).ReadFile(context.Background(), "skills.json")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, skills, string(content))
+			}
+		})
+	}
+}
+
+func TestHTTPSource_ReadFile(t *testing.T) {
+	srv := httptest.NewServer(http.FileServer(http.Dir(writeFiles(t, map[string]string{"skills.json": "[]"}))))
+	defer srv.Close()
+
+	src, err := NewHTTP(srv.URL, srv.Client())
+	require.NoError(t, err)
+
+	content, err := src.ReadFile(context.Background(), "skills.json")
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(content))
+
+	_, err = src.ReadFile(context.Background(), "missing.json")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
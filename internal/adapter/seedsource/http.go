@@ -0,0 +1,68 @@
+package seedsource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// maxSeedFileSize caps how much of a remote seed file is read into memory.
+const maxSeedFileSize = 10 << 20
+
+// HTTPSource reads seed files relative to a base URL.
+type HTTPSource struct {
+	baseURL *url.URL
+	client  *http.Client
+}
+
+// NewHTTP creates a source fetching seed files relative to baseURL.
+// If client is nil, a client with a 30 second timeout is used.
+func NewHTTP(baseURL string, client *http.Client) (*HTTPSource, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("seed source url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("seed source url: unsupported scheme %q", u.Scheme)
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &HTTPSource{baseURL: u, client: client}, nil
+}
+
+// ReadFile fetches the named seed file. A 404 response is reported as fs.ErrNotExist.
+func (s *HTTPSource) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	fileURL := s.baseURL.JoinPath(name).String()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", fileURL, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("fetching %s: %w", fileURL, fs.ErrNotExist)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("fetching %s: unexpected status %s", fileURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSeedFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", fileURL, err)
+	}
+	if len(body) > maxSeedFileSize {
+		return nil, fmt.Errorf("reading %s: file exceeds %d bytes", fileURL, maxSeedFileSize)
+	}
+	return body, nil
+}
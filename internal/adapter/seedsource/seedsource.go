@@ -0,0 +1,61 @@
+package seedsource
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+	"github.com/guillermoBallester/go-platform-cv/sql/data"
+)
+
+// New creates the seed source described by the configuration.
+// Remote sources always verify checksums; other sources verify them when a manifest is present,
+// or always if RequireChecksums is set. The embedded files are trusted as they are.
+func New(cfg config.SeedConfig) (port.SeedSource, error) {
+	switch {
+	case cfg.IsEmbedded():
+		return NewEmbedded(), nil
+	case cfg.IsRemote():
+		src, err := NewHTTP(cfg.Source, nil)
+		if err != nil {
+			return nil, err
+		}
+		return WithChecksums(src, cfg.ChecksumFile, true), nil
+	default:
+		src, err := NewDir(cfg.Source)
+		if err != nil {
+			return nil, err
+		}
+		return WithChecksums(src, cfg.ChecksumFile, cfg.RequireChecksums), nil
+	}
+}
+
+// FSSource reads seed files from an fs.FS.
+type FSSource struct {
+	fsys fs.FS
+}
+
+// NewEmbedded creates a source reading the seed files embedded in the binary.
+func NewEmbedded() *FSSource {
+	return &FSSource{fsys: data.FS}
+}
+
+// NewDir creates a source reading seed files from a directory on disk.
+func NewDir(dir string) (*FSSource, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("seed directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("seed directory: %s is not a directory", dir)
+	}
+	return &FSSource{fsys: os.DirFS(dir)}, nil
+}
+
+// ReadFile returns the contents of the named seed file.
+func (s *FSSource) ReadFile(_ context.Context, name string) ([]byte, error) {
+	return fs.ReadFile(s.fsys, name)
+}
@@ -0,0 +1,147 @@
+// Package static renders the CV as a static site, to be hosted without a server or database.
+package static
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/export"
+	web "github.com/guillermoBallester/go-platform-cv/internal/adapter/handler/http"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+)
+
+// AssetsURL is the relative URL pages link assets under, so the site works from any subpath.
+const AssetsURL = "assets/"
+
+// Options configures a static build.
+type Options struct {
+	// OutputDir is the directory the site is written to. Existing files are overwritten.
+	OutputDir string
+	// BaseURL is the absolute URL the site is published at, e.g. https://example.com/cv/.
+	// Sitemaps require absolute URLs, so the sitemap is only written when it is set.
+	BaseURL string
+}
+
+// Build renders the home page, the JSON and Markdown exports, the assets and, given a base
+// URL, the sitemap into opts.OutputDir. pages must link assets under AssetsURL. It returns
+// the paths written, relative to the output directory.
+func Build(ctx context.Context, cv port.CVReader, pages *web.Pages, opts Options) ([]string, error) {
+	b := &builder{dir: opts.OutputDir}
+
+	home, err := pages.Home(ctx, cv, "")
+	if err != nil {
+		return nil, err
+	}
+	// A published site would keep the missing sections until the next build.
+	if home.Degraded {
+		return nil, errors.New("rendering home page: some sections failed to load")
+	}
+	if err := b.write("index.html", home.Body); err != nil {
+		return nil, err
+	}
+
+	content, err := cv.GetCV(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading CV: %w", err)
+	}
+	for _, format := range []string{export.FormatJSON, export.FormatMarkdown} {
+		var doc bytes.Buffer
+		if err := export.Write(&doc, format, content); err != nil {
+			return nil, fmt.Errorf("exporting %s: %w", format, err)
+		}
+		if err := b.write("cv."+format, doc.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := b.copyAssets(pages); err != nil {
+		return nil, err
+	}
+
+	if opts.BaseURL != "" {
+		sitemap, err := renderSitemap(opts.BaseURL, content.LastModified(), []string{"", "cv.json", "cv.md"})
+		if err != nil {
+			return nil, err
+		}
+		if err := b.write("sitemap.xml", sitemap); err != nil {
+			return nil, err
+		}
+	}
+	return b.written, nil
+}
+
+type builder struct {
+	dir     string
+	written []string
+}
+
+func (b *builder) write(name string, content []byte) error {
+	path := filepath.Join(b.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating directory for %s: %w", name, err)
+	}
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	b.written = append(b.written, name)
+	return nil
+}
+
+// copyAssets writes every asset under its content-hashed name, which pages link, and under
+// its plain name, which seed data and older links may still use.
+func (b *builder) copyAssets(pages *web.Pages) error {
+	fsys, hashed := pages.Assets()
+	for _, name := range slices.Sorted(maps.Keys(hashed)) {
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return fmt.Errorf("reading asset %s: %w", name, err)
+		}
+		if err := b.write(AssetsURL+hashed[name], content); err != nil {
+			return err
+		}
+		if err := b.write(AssetsURL+name, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// renderSitemap lists the pages, given relative to baseURL, in the sitemaps.org format.
+func renderSitemap(baseURL string, modified time.Time, pages []string) ([]byte, error) {
+	baseURL = strings.TrimSuffix(baseURL, "/") + "/"
+	set := urlSet{Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9"}
+	for _, page := range pages {
+		u := sitemapURL{Loc: baseURL + page}
+		if !modified.IsZero() {
+			u.LastMod = modified.UTC().Format(time.DateOnly)
+		}
+		set.URLs = append(set.URLs, u)
+	}
+
+	out, err := xml.MarshalIndent(set, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("rendering sitemap: %w", err)
+	}
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
@@ -0,0 +1,64 @@
+package static
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	web "github.com/guillermoBallester/go-platform-cv/internal/adapter/handler/http"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/memory"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"github.com/guillermoBallester/go-platform-cv/ui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	pages, err := web.LoadPages(config.ThemeConfig{Name: ui.DefaultTheme}, AssetsURL)
+	require.NoError(t, err)
+
+	cv := service.NewCVService(memory.NewStore(domain.CV{
+		Skills: []domain.Skill{{Name: "Go", Category: "Language", LogoPath: "/assets/logos/go.svg"}},
+		Experiences: []domain.Experience{{
+			CompanyName: "Acme",
+			JobTitle:    "Engineer",
+			StartDate:   time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:   time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC),
+		}},
+	}))
+
+	dir := t.TempDir()
+	written, err := Build(context.Background(), cv, pages, Options{OutputDir: dir, BaseURL: "https://example.com/cv"})
+	require.NoError(t, err)
+	assert.Contains(t, written, "index.html")
+	assert.Contains(t, written, "cv.json")
+	assert.Contains(t, written, "cv.md")
+	assert.Contains(t, written, "assets/logos/go.svg")
+	assert.Contains(t, written, "sitemap.xml")
+
+	index, err := os.ReadFile(filepath.Join(dir, "index.html"))
+	require.NoError(t, err)
+	assert.Regexp(t, `src="assets/logos/go\.[0-9a-f]{10}\.svg"`, string(index))
+	assert.NotContains(t, string(index), `src="/assets/`)
+
+	sitemap, err := os.ReadFile(filepath.Join(dir, "sitemap.xml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(sitemap), "<loc>https://example.com/cv/</loc>")
+	assert.Contains(t, string(sitemap), "<loc>https://example.com/cv/cv.md</loc>")
+	assert.Contains(t, string(sitemap), "<lastmod>2026-05-04</lastmod>")
+}
+
+func TestBuildWithoutBaseURL(t *testing.T) {
+	pages, err := web.LoadPages(config.ThemeConfig{Name: ui.DefaultTheme}, AssetsURL)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	written, err := Build(context.Background(), service.NewCVService(memory.NewStore(domain.CV{})), pages, Options{OutputDir: dir})
+	require.NoError(t, err)
+	assert.NotContains(t, written, "sitemap.xml")
+	assert.NoFileExists(t, filepath.Join(dir, "sitemap.xml"))
+}
@@ -0,0 +1,97 @@
+// Package memory holds CV content in process memory, for running without a database.
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+)
+
+// Store is a read-only port.CVStore over a fixed CV. It returns records in the same order as
+// the Postgres queries, so pages render identically from either.
+type Store struct {
+	cv domain.CV
+}
+
+var _ port.CVStore = (*Store)(nil)
+
+// NewStore creates a Store holding cv.
+func NewStore(cv domain.CV) *Store {
+	s := &Store{cv: domain.CV{
+		Skills:       slices.Clone(cv.Skills),
+		Experiences:  slices.Clone(cv.Experiences),
+		Achievements: slices.Clone(cv.Achievements),
+		Projects:     slices.Clone(cv.Projects),
+	}}
+
+	sortSkills(s.cv.Skills)
+	// Newest first, as ORDER BY start_date DESC and date DESC NULLS LAST do.
+	slices.SortStableFunc(s.cv.Experiences, func(a, b domain.Experience) int {
+		return b.StartDate.Compare(a.StartDate)
+	})
+	slices.SortStableFunc(s.cv.Achievements, func(a, b domain.Achievement) int {
+		return newestFirst(a.Date, b.Date)
+	})
+	slices.SortStableFunc(s.cv.Projects, func(a, b domain.Project) int {
+		return newestFirst(a.StartDate, b.StartDate)
+	})
+	for i := range s.cv.Experiences {
+		s.cv.Experiences[i].Skills = sortedSkills(s.cv.Experiences[i].Skills)
+	}
+	for i := range s.cv.Achievements {
+		s.cv.Achievements[i].Skills = sortedSkills(s.cv.Achievements[i].Skills)
+	}
+	for i := range s.cv.Projects {
+		s.cv.Projects[i].Skills = sortedSkills(s.cv.Projects[i].Skills)
+	}
+	return s
+}
+
+// GetSkills returns every skill by category and name.
+func (s *Store) GetSkills(context.Context) ([]domain.Skill, error) {
+	return slices.Clone(s.cv.Skills), nil
+}
+
+// GetAllExperiencesWithSkills returns every experience, newest first.
+func (s *Store) GetAllExperiencesWithSkills(context.Context) ([]domain.Experience, error) {
+	return slices.Clone(s.cv.Experiences), nil
+}
+
+// GetAllAchievementsWithSkills returns every achievement, newest first and undated last.
+func (s *Store) GetAllAchievementsWithSkills(context.Context) ([]domain.Achievement, error) {
+	return slices.Clone(s.cv.Achievements), nil
+}
+
+// GetAllProjectsWithSkills returns every project, newest first and undated last.
+func (s *Store) GetAllProjectsWithSkills(context.Context) ([]domain.Project, error) {
+	return slices.Clone(s.cv.Projects), nil
+}
+
+func sortedSkills(skills []domain.Skill) []domain.Skill {
+	skills = slices.Clone(skills)
+	sortSkills(skills)
+	return skills
+}
+
+func sortSkills(skills []domain.Skill) {
+	slices.SortStableFunc(skills, func(a, b domain.Skill) int {
+		return cmp.Or(cmp.Compare(a.Category, b.Category), cmp.Compare(a.Name, b.Name))
+	})
+}
+
+// newestFirst orders optional times newest first, with nil after every time.
+func newestFirst(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return b.Compare(*a)
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int) *time.Time {
+	t := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestStoreOrdering(t *testing.T) {
+	ctx := context.Background()
+	goSkill := domain.Skill{Name: "Go", Category: "Language"}
+	pgSkill := domain.Skill{Name: "Postgres", Category: "Database"}
+	store := NewStore(domain.CV{
+		Skills: []domain.Skill{goSkill, pgSkill},
+		Experiences: []domain.Experience{
+			{JobTitle: "Old", StartDate: *date(2018)},
+			{JobTitle: "New", StartDate: *date(2024), Skills: []domain.Skill{goSkill, pgSkill}},
+		},
+		Achievements: []domain.Achievement{{Title: "Undated"}, {Title: "Old", Date: date(2019)}, {Title: "New", Date: date(2023)}},
+		Projects:     []domain.Project{{Name: "Undated"}, {Name: "New", StartDate: date(2025)}},
+	})
+
+	skills, err := store.GetSkills(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Postgres", skills[0].Name)
+
+	experiences, err := store.GetAllExperiencesWithSkills(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "New", experiences[0].JobTitle)
+	assert.Equal(t, "Postgres", experiences[0].Skills[0].Name)
+
+	achievements, err := store.GetAllAchievementsWithSkills(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"New", "Old", "Undated"}, []string{achievements[0].Title, achievements[1].Title, achievements[2].Title})
+
+	projects, err := store.GetAllProjectsWithSkills(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "New", projects[0].Name)
+}
+
+func TestStoreReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore(domain.CV{Skills: []domain.Skill{{Name: "Go"}}})
+
+	skills, err := store.GetSkills(ctx)
+	require.NoError(t, err)
+	skills[0].Name = "Changed"
+
+	skills, err = store.GetSkills(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Go", skills[0].Name)
+}
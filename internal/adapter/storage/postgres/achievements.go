@@ -10,16 +10,19 @@ import (
 // AchievementRepo represents a repository for managing achievements.
 type AchievementRepo struct {
 	queries *Queries
+	// publicOnly restricts reads to public records that are not deleted.
+	publicOnly bool
 }
 
-// NewAchievementRepository creates a new instance of AchievementRepo.
-func NewAchievementRepository(q *Queries) *AchievementRepo {
-	return &AchievementRepo{queries: q}
+// NewAchievementRepository creates a new instance of AchievementRepo. With publicOnly, it only reads
+// public achievements that are not deleted.
+func NewAchievementRepository(q *Queries, publicOnly bool) *AchievementRepo {
+	return &AchievementRepo{queries: q, publicOnly: publicOnly}
 }
 
 // GetAchievements retrieves all achievements ordered by date.
 func (r *AchievementRepo) GetAchievements(ctx context.Context) ([]domain.Achievement, error) {
-	dbAchs, err := r.queries.ListAchievements(ctx)
+	dbAchs, err := r.queries.ListAchievements(ctx, r.publicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -28,14 +31,17 @@ func (r *AchievementRepo) GetAchievements(ctx context.Context) ([]domain.Achieve
 
 // GetAllAchievementsWithSkills retrieves all achievements, each with their associated skills.
 func (r *AchievementRepo) GetAllAchievementsWithSkills(ctx context.Context) ([]domain.Achievement, error) {
-	dbAchs, err := r.queries.ListAchievements(ctx)
+	dbAchs, err := r.queries.ListAchievements(ctx, r.publicOnly)
 	if err != nil {
 		return nil, err
 	}
 
 	achievements := make([]domain.Achievement, 0, len(dbAchs))
 	for _, dbAch := range dbAchs {
-		skills, err := r.queries.ListSkillsForAchievement(ctx, dbAch.ID)
+		skills, err := r.queries.ListSkillsForAchievement(ctx, ListSkillsForAchievementParams{
+			AchievementID: dbAch.ID,
+			PublicOnly:    r.publicOnly,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -53,6 +59,7 @@ func (r *AchievementRepo) CreateAchievement(ctx context.Context, a domain.Achiev
 	params := CreateAchievementParams{
 		Title:       a.Title,
 		Description: a.Description,
+		Visibility:  string(a.Visibility),
 	}
 	if a.Date != nil {
 		params.Date = pgtype.Date{Time: *a.Date, Valid: true}
@@ -94,6 +101,7 @@ func (r *AchievementRepo) UpdateAchievement(ctx context.Context, a domain.Achiev
 		ID:          a.ID,
 		Title:       a.Title,
 		Description: a.Description,
+		Visibility:  string(a.Visibility),
 	}
 	if a.Date != nil {
 		params.Date = pgtype.Date{Time: *a.Date, Valid: true}
@@ -113,3 +121,21 @@ func (r *AchievementRepo) UpdateAchievement(ctx context.Context, a domain.Achiev
 func (r *AchievementRepo) ClearSkillsFromAchievement(ctx context.Context, achievementID int32) error {
 	return r.queries.ClearSkillsFromAchievement(ctx, achievementID)
 }
+
+// GetSkillsForAchievement retrieves the skills linked to an achievement.
+func (r *AchievementRepo) GetSkillsForAchievement(ctx context.Context, achievementID int32) ([]domain.Skill, error) {
+	dbSkills, err := r.queries.ListSkillsForAchievement(ctx, ListSkillsForAchievementParams{
+		AchievementID: achievementID,
+		PublicOnly:    r.publicOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSkills(dbSkills), nil
+}
+
+// DeleteAchievement soft-deletes an achievement: it is kept, with its skill links, but no longer
+// read publicly. Updating it restores it.
+func (r *AchievementRepo) DeleteAchievement(ctx context.Context, id int32) error {
+	return r.queries.DeleteAchievement(ctx, id)
+}
@@ -36,9 +36,9 @@ func (q *Queries) ClearSkillsFromAchievement(ctx context.Context, achievementID
 }
 
 const createAchievement = `-- name: CreateAchievement :one
-INSERT INTO achievements (title, description, date, experience_id, project_id)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, title, description, date, experience_id, project_id, created_at, updated_at
+INSERT INTO achievements (title, description, date, experience_id, project_id, visibility)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, title, description, date, experience_id, project_id, created_at, updated_at, visibility, deleted_at
 `
 
 type CreateAchievementParams struct {
@@ -47,6 +47,7 @@ type CreateAchievementParams struct {
 	Date         pgtype.Date `json:"date"`
 	ExperienceID pgtype.Int4 `json:"experience_id"`
 	ProjectID    pgtype.Int4 `json:"project_id"`
+	Visibility   string      `json:"visibility"`
 }
 
 func (q *Queries) CreateAchievement(ctx context.Context, arg CreateAchievementParams) (Achievement, error) {
@@ -56,6 +57,7 @@ func (q *Queries) CreateAchievement(ctx context.Context, arg CreateAchievementPa
 		arg.Date,
 		arg.ExperienceID,
 		arg.ProjectID,
+		arg.Visibility,
 	)
 	var i Achievement
 	err := row.Scan(
@@ -67,12 +69,14 @@ func (q *Queries) CreateAchievement(ctx context.Context, arg CreateAchievementPa
 		&i.ProjectID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const deleteAchievement = `-- name: DeleteAchievement :exec
-DELETE FROM achievements WHERE id = $1
+UPDATE achievements SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) DeleteAchievement(ctx context.Context, id int32) error {
@@ -81,7 +85,7 @@ func (q *Queries) DeleteAchievement(ctx context.Context, id int32) error {
 }
 
 const getAchievement = `-- name: GetAchievement :one
-SELECT id, title, description, date, experience_id, project_id, created_at, updated_at FROM achievements WHERE id = $1
+SELECT id, title, description, date, experience_id, project_id, created_at, updated_at, visibility, deleted_at FROM achievements WHERE id = $1
 `
 
 func (q *Queries) GetAchievement(ctx context.Context, id int32) (Achievement, error) {
@@ -96,12 +100,14 @@ func (q *Queries) GetAchievement(ctx context.Context, id int32) (Achievement, er
 		&i.ProjectID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getAchievementByTitle = `-- name: GetAchievementByTitle :one
-SELECT id, title, description, date, experience_id, project_id, created_at, updated_at FROM achievements WHERE title = $1
+SELECT id, title, description, date, experience_id, project_id, created_at, updated_at, visibility, deleted_at FROM achievements WHERE title = $1
 `
 
 func (q *Queries) GetAchievementByTitle(ctx context.Context, title string) (Achievement, error) {
@@ -116,6 +122,8 @@ func (q *Queries) GetAchievementByTitle(ctx context.Context, title string) (Achi
 		&i.ProjectID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -180,11 +188,24 @@ func (q *Queries) GetAchievementWithSkills(ctx context.Context, id int32) ([]Get
 }
 
 const listAchievements = `-- name: ListAchievements :many
-SELECT id, title, description, date, experience_id, project_id, created_at, updated_at FROM achievements ORDER BY date DESC NULLS LAST
+SELECT a.id, a.title, a.description, a.date, a.experience_id, a.project_id, a.created_at, a.updated_at, a.visibility, a.deleted_at FROM achievements a
+WHERE NOT $1::boolean OR (
+    a.visibility = 'public' AND a.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM experiences e
+        WHERE e.id = a.experience_id AND (e.visibility <> 'public' OR e.deleted_at IS NOT NULL)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM projects p
+        WHERE p.id = a.project_id AND (p.visibility <> 'public' OR p.deleted_at IS NOT NULL)
+    )
+)
+ORDER BY a.date DESC NULLS LAST
 `
 
-func (q *Queries) ListAchievements(ctx context.Context) ([]Achievement, error) {
-	rows, err := q.db.Query(ctx, listAchievements)
+// An achievement is only public if the experience and project it belongs to are too.
+func (q *Queries) ListAchievements(ctx context.Context, publicOnly bool) ([]Achievement, error) {
+	rows, err := q.db.Query(ctx, listAchievements, publicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +222,8 @@ func (q *Queries) ListAchievements(ctx context.Context) ([]Achievement, error) {
 			&i.ProjectID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -213,7 +236,7 @@ func (q *Queries) ListAchievements(ctx context.Context) ([]Achievement, error) {
 }
 
 const listAchievementsForExperience = `-- name: ListAchievementsForExperience :many
-SELECT id, title, description, date, experience_id, project_id, created_at, updated_at FROM achievements WHERE experience_id = $1 ORDER BY date DESC NULLS LAST
+SELECT id, title, description, date, experience_id, project_id, created_at, updated_at, visibility, deleted_at FROM achievements WHERE experience_id = $1 ORDER BY date DESC NULLS LAST
 `
 
 // Filter by context
@@ -235,6 +258,8 @@ func (q *Queries) ListAchievementsForExperience(ctx context.Context, experienceI
 			&i.ProjectID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -247,7 +272,7 @@ func (q *Queries) ListAchievementsForExperience(ctx context.Context, experienceI
 }
 
 const listAchievementsForProject = `-- name: ListAchievementsForProject :many
-SELECT id, title, description, date, experience_id, project_id, created_at, updated_at FROM achievements WHERE project_id = $1 ORDER BY date DESC NULLS LAST
+SELECT id, title, description, date, experience_id, project_id, created_at, updated_at, visibility, deleted_at FROM achievements WHERE project_id = $1 ORDER BY date DESC NULLS LAST
 `
 
 func (q *Queries) ListAchievementsForProject(ctx context.Context, projectID pgtype.Int4) ([]Achievement, error) {
@@ -268,6 +293,8 @@ func (q *Queries) ListAchievementsForProject(ctx context.Context, projectID pgty
 			&i.ProjectID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -280,7 +307,7 @@ func (q *Queries) ListAchievementsForProject(ctx context.Context, projectID pgty
 }
 
 const listAchievementsForSkill = `-- name: ListAchievementsForSkill :many
-SELECT a.id, a.title, a.description, a.date, a.experience_id, a.project_id, a.created_at, a.updated_at FROM achievements a
+SELECT a.id, a.title, a.description, a.date, a.experience_id, a.project_id, a.created_at, a.updated_at, a.visibility, a.deleted_at FROM achievements a
 JOIN achievement_skills aks ON a.id = aks.achievement_id
 WHERE aks.skill_id = $1
 ORDER BY a.date DESC NULLS LAST
@@ -304,6 +331,8 @@ func (q *Queries) ListAchievementsForSkill(ctx context.Context, skillID int32) (
 			&i.ProjectID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -366,14 +395,20 @@ func (q *Queries) ListAchievementsWithContext(ctx context.Context) ([]ListAchiev
 }
 
 const listSkillsForAchievement = `-- name: ListSkillsForAchievement :many
-SELECT s.id, s.name, s.category, s.proficiency, s.logo_url FROM skills s
+SELECT s.id, s.name, s.category, s.proficiency, s.logo_url, s.created_at, s.updated_at, s.visibility, s.deleted_at FROM skills s
 JOIN achievement_skills aks ON s.id = aks.skill_id
 WHERE aks.achievement_id = $1
+  AND (NOT $2::boolean OR (s.visibility = 'public' AND s.deleted_at IS NULL))
 ORDER BY s.category, s.name
 `
 
-func (q *Queries) ListSkillsForAchievement(ctx context.Context, achievementID int32) ([]Skill, error) {
-	rows, err := q.db.Query(ctx, listSkillsForAchievement, achievementID)
+type ListSkillsForAchievementParams struct {
+	AchievementID int32 `json:"achievement_id"`
+	PublicOnly    bool  `json:"public_only"`
+}
+
+func (q *Queries) ListSkillsForAchievement(ctx context.Context, arg ListSkillsForAchievementParams) ([]Skill, error) {
+	rows, err := q.db.Query(ctx, listSkillsForAchievement, arg.AchievementID, arg.PublicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -387,6 +422,10 @@ func (q *Queries) ListSkillsForAchievement(ctx context.Context, achievementID in
 			&i.Category,
 			&i.Proficiency,
 			&i.LogoUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -415,9 +454,9 @@ func (q *Queries) RemoveSkillFromAchievement(ctx context.Context, arg RemoveSkil
 const updateAchievement = `-- name: UpdateAchievement :one
 UPDATE achievements
 SET title = $2, description = $3, date = $4, experience_id = $5,
-    project_id = $6, updated_at = NOW()
+    project_id = $6, visibility = $7, deleted_at = NULL, updated_at = NOW()
 WHERE id = $1
-RETURNING id, title, description, date, experience_id, project_id, created_at, updated_at
+RETURNING id, title, description, date, experience_id, project_id, created_at, updated_at, visibility, deleted_at
 `
 
 type UpdateAchievementParams struct {
@@ -427,6 +466,7 @@ type UpdateAchievementParams struct {
 	Date         pgtype.Date `json:"date"`
 	ExperienceID pgtype.Int4 `json:"experience_id"`
 	ProjectID    pgtype.Int4 `json:"project_id"`
+	Visibility   string      `json:"visibility"`
 }
 
 func (q *Queries) UpdateAchievement(ctx context.Context, arg UpdateAchievementParams) (Achievement, error) {
@@ -437,6 +477,7 @@ func (q *Queries) UpdateAchievement(ctx context.Context, arg UpdateAchievementPa
 		arg.Date,
 		arg.ExperienceID,
 		arg.ProjectID,
+		arg.Visibility,
 	)
 	var i Achievement
 	err := row.Scan(
@@ -448,6 +489,8 @@ func (q *Queries) UpdateAchievement(ctx context.Context, arg UpdateAchievementPa
 		&i.ProjectID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AuditRepo reads the audit log, and attributes the changes of a transaction in it.
+type AuditRepo struct {
+	queries *Queries
+}
+
+// NewAuditRepository creates a new instance of AuditRepo initialized with the provided Queries struct.
+func NewAuditRepository(q *Queries) *AuditRepo {
+	return &AuditRepo{queries: q}
+}
+
+// SetActor attributes every change made for the rest of the transaction to actor. Outside a
+// transaction it has no lasting effect, and changes are attributed to the database user.
+func (r *AuditRepo) SetActor(ctx context.Context, actor string) error {
+	return r.queries.SetAuditActor(ctx, actor)
+}
+
+// ListAuditEvents returns the events matching filter, newest first.
+func (r *AuditRepo) ListAuditEvents(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	dbEvents, err := r.queries.ListAuditEvents(ctx, ListAuditEventsParams{
+		Entity:     pgtype.Text{String: filter.Entity, Valid: filter.Entity != ""},
+		Since:      pgtype.Timestamptz{Time: filter.Since, Valid: !filter.Since.IsZero()},
+		Until:      pgtype.Timestamptz{Time: filter.Until, Valid: !filter.Until.IsZero()},
+		Cursor:     pgtype.Int8{Int64: filter.Before, Valid: filter.Before > 0},
+		MaxResults: int32(filter.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.AuditEvent, len(dbEvents))
+	for i, e := range dbEvents {
+		events[i] = toDomainAuditEvent(e)
+	}
+	return events, nil
+}
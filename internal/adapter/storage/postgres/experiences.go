@@ -10,16 +10,19 @@ import (
 // ExperienceRepo represents a repository for managing experiences.
 type ExperienceRepo struct {
 	queries *Queries
+	// publicOnly restricts reads to public records that are not deleted.
+	publicOnly bool
 }
 
-// NewExperienceRepository creates a new instance of ExperienceRepo.
-func NewExperienceRepository(q *Queries) *ExperienceRepo {
-	return &ExperienceRepo{queries: q}
+// NewExperienceRepository creates a new instance of ExperienceRepo. With publicOnly, it only reads
+// public experiences that are not deleted.
+func NewExperienceRepository(q *Queries, publicOnly bool) *ExperienceRepo {
+	return &ExperienceRepo{queries: q, publicOnly: publicOnly}
 }
 
 // GetExperiences retrieves all experiences ordered by start date.
 func (r *ExperienceRepo) GetExperiences(ctx context.Context) ([]domain.Experience, error) {
-	dbExps, err := r.queries.ListExperiences(ctx)
+	dbExps, err := r.queries.ListExperiences(ctx, r.publicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +75,7 @@ func (r *ExperienceRepo) GetExperienceWithSkills(ctx context.Context, id int32)
 // GetAllExperiencesWithSkills retrieves all experiences, each with their associated skills.
 func (r *ExperienceRepo) GetAllExperiencesWithSkills(ctx context.Context) ([]domain.Experience, error) {
 	// First get all experiences
-	dbExps, err := r.queries.ListExperiences(ctx)
+	dbExps, err := r.queries.ListExperiences(ctx, r.publicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +83,10 @@ func (r *ExperienceRepo) GetAllExperiencesWithSkills(ctx context.Context) ([]dom
 	experiences := make([]domain.Experience, 0, len(dbExps))
 	for _, dbExp := range dbExps {
 		// Get skills for each experience
-		skills, err := r.queries.ListSkillsForExperience(ctx, dbExp.ID)
+		skills, err := r.queries.ListSkillsForExperience(ctx, ListSkillsForExperienceParams{
+			ExperienceID: dbExp.ID,
+			PublicOnly:   r.publicOnly,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -102,6 +108,7 @@ func (r *ExperienceRepo) CreateExperience(ctx context.Context, e domain.Experien
 		StartDate:   pgtype.Date{Time: e.StartDate, Valid: true},
 		Description: e.Description,
 		Highlights:  pgtype.Text{String: e.Highlights, Valid: e.Highlights != ""},
+		Visibility:  string(e.Visibility),
 	}
 	if e.EndDate != nil {
 		params.EndDate = pgtype.Date{Time: *e.EndDate, Valid: true}
@@ -144,6 +151,7 @@ func (r *ExperienceRepo) UpdateExperience(ctx context.Context, e domain.Experien
 		StartDate:   pgtype.Date{Time: e.StartDate, Valid: true},
 		Description: e.Description,
 		Highlights:  pgtype.Text{String: e.Highlights, Valid: e.Highlights != ""},
+		Visibility:  string(e.Visibility),
 	}
 	if e.EndDate != nil {
 		params.EndDate = pgtype.Date{Time: *e.EndDate, Valid: true}
@@ -157,3 +165,21 @@ func (r *ExperienceRepo) UpdateExperience(ctx context.Context, e domain.Experien
 func (r *ExperienceRepo) ClearSkillsFromExperience(ctx context.Context, experienceID int32) error {
 	return r.queries.ClearSkillsFromExperience(ctx, experienceID)
 }
+
+// GetSkillsForExperience retrieves the skills linked to an experience.
+func (r *ExperienceRepo) GetSkillsForExperience(ctx context.Context, experienceID int32) ([]domain.Skill, error) {
+	dbSkills, err := r.queries.ListSkillsForExperience(ctx, ListSkillsForExperienceParams{
+		ExperienceID: experienceID,
+		PublicOnly:   r.publicOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSkills(dbSkills), nil
+}
+
+// DeleteExperience soft-deletes an experience: it is kept, with its links, but neither it nor
+// its achievements are read publicly any more. Updating it restores it.
+func (r *ExperienceRepo) DeleteExperience(ctx context.Context, id int32) error {
+	return r.queries.DeleteExperience(ctx, id)
+}
@@ -51,9 +51,9 @@ func (q *Queries) ClearSkillsFromExperience(ctx context.Context, experienceID in
 }
 
 const createExperience = `-- name: CreateExperience :one
-INSERT INTO experiences (company_name, job_title, location, start_date, end_date, description, highlights)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at
+INSERT INTO experiences (company_name, job_title, location, start_date, end_date, description, highlights, visibility)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at, visibility, deleted_at
 `
 
 type CreateExperienceParams struct {
@@ -64,6 +64,7 @@ type CreateExperienceParams struct {
 	EndDate     pgtype.Date `json:"end_date"`
 	Description string      `json:"description"`
 	Highlights  pgtype.Text `json:"highlights"`
+	Visibility  string      `json:"visibility"`
 }
 
 func (q *Queries) CreateExperience(ctx context.Context, arg CreateExperienceParams) (Experience, error) {
@@ -75,6 +76,7 @@ func (q *Queries) CreateExperience(ctx context.Context, arg CreateExperiencePara
 		arg.EndDate,
 		arg.Description,
 		arg.Highlights,
+		arg.Visibility,
 	)
 	var i Experience
 	err := row.Scan(
@@ -88,12 +90,14 @@ func (q *Queries) CreateExperience(ctx context.Context, arg CreateExperiencePara
 		&i.Highlights,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const deleteExperience = `-- name: DeleteExperience :exec
-DELETE FROM experiences WHERE id = $1
+UPDATE experiences SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) DeleteExperience(ctx context.Context, id int32) error {
@@ -102,7 +106,7 @@ func (q *Queries) DeleteExperience(ctx context.Context, id int32) error {
 }
 
 const getExperience = `-- name: GetExperience :one
-SELECT id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at FROM experiences WHERE id = $1
+SELECT id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at, visibility, deleted_at FROM experiences WHERE id = $1
 `
 
 func (q *Queries) GetExperience(ctx context.Context, id int32) (Experience, error) {
@@ -119,12 +123,14 @@ func (q *Queries) GetExperience(ctx context.Context, id int32) (Experience, erro
 		&i.Highlights,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getExperienceByCompanyAndTitle = `-- name: GetExperienceByCompanyAndTitle :one
-SELECT id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at FROM experiences WHERE company_name = $1 AND job_title = $2
+SELECT id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at, visibility, deleted_at FROM experiences WHERE company_name = $1 AND job_title = $2
 `
 
 type GetExperienceByCompanyAndTitleParams struct {
@@ -146,6 +152,8 @@ func (q *Queries) GetExperienceByCompanyAndTitle(ctx context.Context, arg GetExp
 		&i.Highlights,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -214,11 +222,13 @@ func (q *Queries) GetExperienceWithSkills(ctx context.Context, id int32) ([]GetE
 }
 
 const listExperiences = `-- name: ListExperiences :many
-SELECT id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at FROM experiences ORDER BY start_date DESC
+SELECT id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at, visibility, deleted_at FROM experiences
+WHERE NOT $1::boolean OR (visibility = 'public' AND deleted_at IS NULL)
+ORDER BY start_date DESC
 `
 
-func (q *Queries) ListExperiences(ctx context.Context) ([]Experience, error) {
-	rows, err := q.db.Query(ctx, listExperiences)
+func (q *Queries) ListExperiences(ctx context.Context, publicOnly bool) ([]Experience, error) {
+	rows, err := q.db.Query(ctx, listExperiences, publicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +247,8 @@ func (q *Queries) ListExperiences(ctx context.Context) ([]Experience, error) {
 			&i.Highlights,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -249,7 +261,7 @@ func (q *Queries) ListExperiences(ctx context.Context) ([]Experience, error) {
 }
 
 const listExperiencesForSkill = `-- name: ListExperiencesForSkill :many
-SELECT e.id, e.company_name, e.job_title, e.location, e.start_date, e.end_date, e.description, e.highlights, e.created_at, e.updated_at FROM experiences e
+SELECT e.id, e.company_name, e.job_title, e.location, e.start_date, e.end_date, e.description, e.highlights, e.created_at, e.updated_at, e.visibility, e.deleted_at FROM experiences e
 JOIN experience_skills es ON e.id = es.experience_id
 WHERE es.skill_id = $1
 ORDER BY e.start_date DESC
@@ -275,6 +287,8 @@ func (q *Queries) ListExperiencesForSkill(ctx context.Context, skillID int32) ([
 			&i.Highlights,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -287,7 +301,7 @@ func (q *Queries) ListExperiencesForSkill(ctx context.Context, skillID int32) ([
 }
 
 const listProjectsForExperience = `-- name: ListProjectsForExperience :many
-SELECT p.id, p.name, p.description, p.start_date, p.end_date, p.created_at, p.updated_at FROM projects p
+SELECT p.id, p.name, p.description, p.start_date, p.end_date, p.created_at, p.updated_at, p.visibility, p.deleted_at FROM projects p
 JOIN experience_projects ep ON p.id = ep.project_id
 WHERE ep.experience_id = $1
 ORDER BY p.start_date DESC
@@ -310,6 +324,8 @@ func (q *Queries) ListProjectsForExperience(ctx context.Context, experienceID in
 			&i.EndDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -322,14 +338,20 @@ func (q *Queries) ListProjectsForExperience(ctx context.Context, experienceID in
 }
 
 const listSkillsForExperience = `-- name: ListSkillsForExperience :many
-SELECT s.id, s.name, s.category, s.proficiency, s.logo_url FROM skills s
+SELECT s.id, s.name, s.category, s.proficiency, s.logo_url, s.created_at, s.updated_at, s.visibility, s.deleted_at FROM skills s
 JOIN experience_skills es ON s.id = es.skill_id
 WHERE es.experience_id = $1
+  AND (NOT $2::boolean OR (s.visibility = 'public' AND s.deleted_at IS NULL))
 ORDER BY s.category, s.name
 `
 
-func (q *Queries) ListSkillsForExperience(ctx context.Context, experienceID int32) ([]Skill, error) {
-	rows, err := q.db.Query(ctx, listSkillsForExperience, experienceID)
+type ListSkillsForExperienceParams struct {
+	ExperienceID int32 `json:"experience_id"`
+	PublicOnly   bool  `json:"public_only"`
+}
+
+func (q *Queries) ListSkillsForExperience(ctx context.Context, arg ListSkillsForExperienceParams) ([]Skill, error) {
+	rows, err := q.db.Query(ctx, listSkillsForExperience, arg.ExperienceID, arg.PublicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -343,6 +365,10 @@ func (q *Queries) ListSkillsForExperience(ctx context.Context, experienceID int3
 			&i.Category,
 			&i.Proficiency,
 			&i.LogoUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -385,9 +411,9 @@ func (q *Queries) RemoveSkillFromExperience(ctx context.Context, arg RemoveSkill
 const updateExperience = `-- name: UpdateExperience :one
 UPDATE experiences
 SET company_name = $2, job_title = $3, location = $4, start_date = $5, end_date = $6,
-    description = $7, highlights = $8, updated_at = NOW()
+    description = $7, highlights = $8, visibility = $9, deleted_at = NULL, updated_at = NOW()
 WHERE id = $1
-RETURNING id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at
+RETURNING id, company_name, job_title, location, start_date, end_date, description, highlights, created_at, updated_at, visibility, deleted_at
 `
 
 type UpdateExperienceParams struct {
@@ -399,6 +425,7 @@ type UpdateExperienceParams struct {
 	EndDate     pgtype.Date `json:"end_date"`
 	Description string      `json:"description"`
 	Highlights  pgtype.Text `json:"highlights"`
+	Visibility  string      `json:"visibility"`
 }
 
 func (q *Queries) UpdateExperience(ctx context.Context, arg UpdateExperienceParams) (Experience, error) {
@@ -411,6 +438,7 @@ func (q *Queries) UpdateExperience(ctx context.Context, arg UpdateExperiencePara
 		arg.EndDate,
 		arg.Description,
 		arg.Highlights,
+		arg.Visibility,
 	)
 	var i Experience
 	err := row.Scan(
@@ -424,6 +452,8 @@ func (q *Queries) UpdateExperience(ctx context.Context, arg UpdateExperiencePara
 		&i.Highlights,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CVChangedChannel is notified by triggers on every committed write to CV content.
+const CVChangedChannel = "cv_changed"
+
+const (
+	listenerMinBackoff = time.Second
+	listenerMaxBackoff = time.Minute
+)
+
+// Listener calls onNotify for every notification on a channel. It listens on a dedicated
+// connection, outside the pool, and reconnects with exponential backoff when it is lost.
+// onNotify also runs once listening starts, as notifications sent while not listening are lost.
+type Listener struct {
+	connConfig *pgx.ConnConfig
+	channel    string
+	onNotify   func(ctx context.Context)
+}
+
+// NewListener creates a Listener for channel on the database described by connConfig.
+func NewListener(connConfig *pgx.ConnConfig, channel string, onNotify func(ctx context.Context)) *Listener {
+	return &Listener{
+		connConfig: connConfig,
+		channel:    channel,
+		onNotify:   onNotify,
+	}
+}
+
+// Run listens until ctx is cancelled.
+func (l *Listener) Run(ctx context.Context) {
+	backoff := listenerMinBackoff
+	for {
+		listened, err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if listened {
+			backoff = listenerMinBackoff
+		}
+		slog.WarnContext(ctx, "notification listener disconnected",
+			"channel", l.channel, "error", err, "retry_in", backoff.String())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, listenerMaxBackoff)
+	}
+}
+
+// listen connects, listens and waits for notifications until the connection fails. It
+// reports whether listening had started.
+func (l *Listener) listen(ctx context.Context) (bool, error) {
+	conn, err := pgx.ConnectConfig(ctx, l.connConfig)
+	if err != nil {
+		return false, fmt.Errorf("connecting: %w", err)
+	}
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return false, fmt.Errorf("listening: %w", err)
+	}
+	slog.InfoContext(ctx, "listening for notifications", "channel", l.channel)
+	l.onNotify(ctx)
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return true, fmt.Errorf("waiting for notification: %w", err)
+		}
+		l.onNotify(ctx)
+	}
+}
@@ -1,7 +1,10 @@
 package postgres
 
 import (
+	"time"
+
 	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // toDomainSkill converts a Skill object to a domain.Skill object with modified field names.
@@ -12,6 +15,10 @@ func toDomainSkill(s Skill) domain.Skill {
 		Category:    s.Category,
 		Proficiency: s.Proficiency.Int32,
 		LogoPath:    s.LogoUrl.String,
+		CreatedAt:   s.CreatedAt.Time,
+		UpdatedAt:   s.UpdatedAt.Time,
+		Visibility:  domain.Visibility(s.Visibility),
+		DeletedAt:   toTimePtr(s.DeletedAt),
 	}
 }
 
@@ -36,6 +43,8 @@ func toDomainExperience(e Experience) domain.Experience {
 		Highlights:  e.Highlights.String,
 		CreatedAt:   e.CreatedAt.Time,
 		UpdatedAt:   e.UpdatedAt.Time,
+		Visibility:  domain.Visibility(e.Visibility),
+		DeletedAt:   toTimePtr(e.DeletedAt),
 	}
 	if e.EndDate.Valid {
 		endDate := e.EndDate.Time
@@ -61,6 +70,8 @@ func toDomainProject(p Project) domain.Project {
 		Description: p.Description,
 		CreatedAt:   p.CreatedAt.Time,
 		UpdatedAt:   p.UpdatedAt.Time,
+		Visibility:  domain.Visibility(p.Visibility),
+		DeletedAt:   toTimePtr(p.DeletedAt),
 	}
 	if p.StartDate.Valid {
 		startDate := p.StartDate.Time
@@ -90,6 +101,8 @@ func toDomainAchievement(a Achievement) domain.Achievement {
 		Description: a.Description,
 		CreatedAt:   a.CreatedAt.Time,
 		UpdatedAt:   a.UpdatedAt.Time,
+		Visibility:  domain.Visibility(a.Visibility),
+		DeletedAt:   toTimePtr(a.DeletedAt),
 	}
 	if a.Date.Valid {
 		date := a.Date.Time
@@ -114,3 +127,33 @@ func toDomainAchievements(dbAchs []Achievement) []domain.Achievement {
 	}
 	return achs
 }
+
+// toDomainAuditEvent converts an AuditEvent object to a domain.AuditEvent object.
+func toDomainAuditEvent(e AuditEvent) domain.AuditEvent {
+	return domain.AuditEvent{
+		ID:         e.ID,
+		OccurredAt: e.OccurredAt.Time,
+		Actor:      e.Actor,
+		Entity:     e.Entity,
+		EntityID:   e.EntityID,
+		Action:     e.Action,
+		Before:     e.Before,
+		After:      e.After,
+	}
+}
+
+func toDomainSnapshotInfo(version int32, label pgtype.Text, createdAt pgtype.Timestamptz) domain.SnapshotInfo {
+	return domain.SnapshotInfo{
+		Version:   version,
+		Label:     label.String,
+		CreatedAt: createdAt.Time,
+	}
+}
+
+// toTimePtr converts a nullable timestamp to a time pointer, nil for NULL.
+func toTimePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -17,6 +17,8 @@ type Achievement struct {
 	ProjectID    pgtype.Int4        `json:"project_id"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	Visibility   string             `json:"visibility"`
+	DeletedAt    pgtype.Timestamptz `json:"deleted_at"`
 }
 
 type AchievementSkill struct {
@@ -24,6 +26,24 @@ type AchievementSkill struct {
 	SkillID       int32 `json:"skill_id"`
 }
 
+type AuditEvent struct {
+	ID         int64              `json:"id"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+	Actor      string             `json:"actor"`
+	Entity     string             `json:"entity"`
+	EntityID   int32              `json:"entity_id"`
+	Action     string             `json:"action"`
+	Before     []byte             `json:"before"`
+	After      []byte             `json:"after"`
+}
+
+type CvSnapshot struct {
+	Version   int32              `json:"version"`
+	Label     pgtype.Text        `json:"label"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	Content   []byte             `json:"content"`
+}
+
 type Experience struct {
 	ID          int32              `json:"id"`
 	CompanyName string             `json:"company_name"`
@@ -35,6 +55,8 @@ type Experience struct {
 	Highlights  pgtype.Text        `json:"highlights"`
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	Visibility  string             `json:"visibility"`
+	DeletedAt   pgtype.Timestamptz `json:"deleted_at"`
 }
 
 type ExperienceProject struct {
@@ -55,6 +77,8 @@ type Project struct {
 	EndDate     pgtype.Date        `json:"end_date"`
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	Visibility  string             `json:"visibility"`
+	DeletedAt   pgtype.Timestamptz `json:"deleted_at"`
 }
 
 type ProjectSkill struct {
@@ -63,9 +87,13 @@ type ProjectSkill struct {
 }
 
 type Skill struct {
-	ID          int32       `json:"id"`
-	Name        string      `json:"name"`
-	Category    string      `json:"category"`
-	Proficiency pgtype.Int4 `json:"proficiency"`
-	LogoUrl     pgtype.Text `json:"logo_url"`
+	ID          int32              `json:"id"`
+	Name        string             `json:"name"`
+	Category    string             `json:"category"`
+	Proficiency pgtype.Int4        `json:"proficiency"`
+	LogoUrl     pgtype.Text        `json:"logo_url"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	Visibility  string             `json:"visibility"`
+	DeletedAt   pgtype.Timestamptz `json:"deleted_at"`
 }
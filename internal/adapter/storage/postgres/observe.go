@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// QueryObserver is notified of the duration and outcome of every repository query.
+type QueryObserver interface {
+	ObserveQuery(repository, query string, duration time.Duration, err error)
+}
+
+// observedDB wraps a DBTX so every query is reported to an observer under its repository.
+// A query is timed until its rows are closed or its row is scanned, so the time spent
+// reading results counts.
+type observedDB struct {
+	db         DBTX
+	repository string
+	observer   QueryObserver
+}
+
+func (o observedDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	start := time.Now()
+	tag, err := o.db.Exec(ctx, sql, args...)
+	o.observer.ObserveQuery(o.repository, queryName(sql), time.Since(start), err)
+	return tag, err
+}
+
+func (o observedDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	start := time.Now()
+	rows, err := o.db.Query(ctx, sql, args...)
+	if err != nil {
+		o.observer.ObserveQuery(o.repository, queryName(sql), time.Since(start), err)
+		return rows, err
+	}
+	return &observedRows{Rows: rows, done: func() {
+		o.observer.ObserveQuery(o.repository, queryName(sql), time.Since(start), rows.Err())
+	}}, nil
+}
+
+func (o observedDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	start := time.Now()
+	row := o.db.QueryRow(ctx, sql, args...)
+	return observedRow{row: row, done: func(err error) {
+		o.observer.ObserveQuery(o.repository, queryName(sql), time.Since(start), err)
+	}}
+}
+
+type observedRows struct {
+	pgx.Rows
+	once sync.Once
+	done func()
+}
+
+func (r *observedRows) Close() {
+	r.Rows.Close()
+	r.once.Do(r.done)
+}
+
+type observedRow struct {
+	row  pgx.Row
+	done func(err error)
+}
+
+func (r observedRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	r.done(err)
+	return err
+}
+
+// queryName returns the sqlc query name from the "-- name: ListSkills :many" header of a
+// generated query, or "unknown" for hand-written SQL.
+func queryName(sql string) string {
+	header, ok := strings.CutPrefix(sql, "-- name: ")
+	if !ok {
+		return "unknown"
+	}
+	name, _, _ := strings.Cut(header, " ")
+	return name
+}
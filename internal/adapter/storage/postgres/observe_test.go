@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryName(t *testing.T) {
+	tests := []struct {
+		sql      string
+		expected string
+	}{
+		{listSkills, "ListSkills"},
+		{getSkillByName, "GetSkillByName"},
+		{"SELECT 1", "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, queryName(tt.sql))
+		})
+	}
+}
@@ -1,6 +1,13 @@
 package postgres
 
-import "github.com/jackc/pgx/v5/pgxpool"
+import (
+	"context"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
 
 // Repositories represents a collection of different repositories to manage skills, experiences, achievements, and projects.
 type Repositories struct {
@@ -8,15 +15,76 @@ type Repositories struct {
 	Experiences  *ExperienceRepo
 	Achievements *AchievementRepo
 	Projects     *ProjectRepo
+	Audit        *AuditRepo
+	Snapshots    *SnapshotRepo
+
+	db         DBTX
+	observer   QueryObserver
+	publicOnly bool
 }
 
+var (
+	_ port.CVStore       = (*Repositories)(nil)
+	_ port.AuditLog      = (*AuditRepo)(nil)
+	_ port.SnapshotStore = (*SnapshotRepo)(nil)
+)
+
 // NewRepositories creates a new instance of Repositories with repositories for managing skills, experiences, achievements, and projects.
-func NewRepositories(db *pgxpool.Pool) *Repositories {
-	queries := New(db)
+// If observer is not nil, it is notified of every query, labelled with the repository that ran it.
+// They read every record, whatever its visibility and even if deleted, as admins and the seeder
+// need; see Public.
+func NewRepositories(db *pgxpool.Pool, observer QueryObserver) *Repositories {
+	return newRepositories(db, observer, false)
+}
+
+// WithTx returns a copy of the repositories whose queries all run inside the given transaction.
+func (r *Repositories) WithTx(tx pgx.Tx) *Repositories {
+	return newRepositories(tx, r.observer, r.publicOnly)
+}
+
+// Public returns a copy of the repositories that only read what the public CV shows: public
+// records that are not deleted, leaving out the achievements of hidden experiences and projects.
+func (r *Repositories) Public() *Repositories {
+	return newRepositories(r.db, r.observer, true)
+}
+
+func newRepositories(db DBTX, observer QueryObserver, publicOnly bool) *Repositories {
+	queries := func(repository string) *Queries {
+		if observer == nil {
+			return New(db)
+		}
+		return New(observedDB{db: db, repository: repository, observer: observer})
+	}
+
 	return &Repositories{
-		Skills:       NewSkillRepository(queries),
-		Experiences:  NewExperienceRepository(queries),
-		Achievements: NewAchievementRepository(queries),
-		Projects:     NewProjectRepository(queries),
+		Skills:       NewSkillRepository(queries("skills"), publicOnly),
+		Experiences:  NewExperienceRepository(queries("experiences"), publicOnly),
+		Achievements: NewAchievementRepository(queries("achievements"), publicOnly),
+		Projects:     NewProjectRepository(queries("projects"), publicOnly),
+		Audit:        NewAuditRepository(queries("audit")),
+		Snapshots:    NewSnapshotRepository(queries("snapshots")),
+		db:           db,
+		observer:     observer,
+		publicOnly:   publicOnly,
 	}
 }
+
+// GetSkills returns every skill.
+func (r *Repositories) GetSkills(ctx context.Context) ([]domain.Skill, error) {
+	return r.Skills.GetSkills(ctx)
+}
+
+// GetAllExperiencesWithSkills returns every experience with its skills.
+func (r *Repositories) GetAllExperiencesWithSkills(ctx context.Context) ([]domain.Experience, error) {
+	return r.Experiences.GetAllExperiencesWithSkills(ctx)
+}
+
+// GetAllAchievementsWithSkills returns every achievement with its skills.
+func (r *Repositories) GetAllAchievementsWithSkills(ctx context.Context) ([]domain.Achievement, error) {
+	return r.Achievements.GetAllAchievementsWithSkills(ctx)
+}
+
+// GetAllProjectsWithSkills returns every project with its skills.
+func (r *Repositories) GetAllProjectsWithSkills(ctx context.Context) ([]domain.Project, error) {
+	return r.Projects.GetAllProjectsWithSkills(ctx)
+}
@@ -10,16 +10,19 @@ import (
 // ProjectRepo represents a repository for managing projects.
 type ProjectRepo struct {
 	queries *Queries
+	// publicOnly restricts reads to public records that are not deleted.
+	publicOnly bool
 }
 
-// NewProjectRepository creates a new instance of ProjectRepo.
-func NewProjectRepository(q *Queries) *ProjectRepo {
-	return &ProjectRepo{queries: q}
+// NewProjectRepository creates a new instance of ProjectRepo. With publicOnly, it only reads
+// public projects that are not deleted.
+func NewProjectRepository(q *Queries, publicOnly bool) *ProjectRepo {
+	return &ProjectRepo{queries: q, publicOnly: publicOnly}
 }
 
 // GetProjects retrieves all projects ordered by start date.
 func (r *ProjectRepo) GetProjects(ctx context.Context) ([]domain.Project, error) {
-	dbProjs, err := r.queries.ListProjects(ctx)
+	dbProjs, err := r.queries.ListProjects(ctx, r.publicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -28,14 +31,17 @@ func (r *ProjectRepo) GetProjects(ctx context.Context) ([]domain.Project, error)
 
 // GetAllProjectsWithSkills retrieves all projects, each with their associated skills.
 func (r *ProjectRepo) GetAllProjectsWithSkills(ctx context.Context) ([]domain.Project, error) {
-	dbProjs, err := r.queries.ListProjects(ctx)
+	dbProjs, err := r.queries.ListProjects(ctx, r.publicOnly)
 	if err != nil {
 		return nil, err
 	}
 
 	projects := make([]domain.Project, 0, len(dbProjs))
 	for _, dbProj := range dbProjs {
-		skills, err := r.queries.ListSkillsForProject(ctx, dbProj.ID)
+		skills, err := r.queries.ListSkillsForProject(ctx, ListSkillsForProjectParams{
+			ProjectID:  dbProj.ID,
+			PublicOnly: r.publicOnly,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -53,6 +59,7 @@ func (r *ProjectRepo) CreateProject(ctx context.Context, p domain.Project) (int3
 	params := CreateProjectParams{
 		Name:        p.Name,
 		Description: p.Description,
+		Visibility:  string(p.Visibility),
 	}
 	if p.StartDate != nil {
 		params.StartDate = pgtype.Date{Time: *p.StartDate, Valid: true}
@@ -91,6 +98,7 @@ func (r *ProjectRepo) UpdateProject(ctx context.Context, p domain.Project) error
 		ID:          p.ID,
 		Name:        p.Name,
 		Description: p.Description,
+		Visibility:  string(p.Visibility),
 	}
 	if p.StartDate != nil {
 		params.StartDate = pgtype.Date{Time: *p.StartDate, Valid: true}
@@ -107,3 +115,21 @@ func (r *ProjectRepo) UpdateProject(ctx context.Context, p domain.Project) error
 func (r *ProjectRepo) ClearSkillsFromProject(ctx context.Context, projectID int32) error {
 	return r.queries.ClearSkillsFromProject(ctx, projectID)
 }
+
+// GetSkillsForProject retrieves the skills linked to a project.
+func (r *ProjectRepo) GetSkillsForProject(ctx context.Context, projectID int32) ([]domain.Skill, error) {
+	dbSkills, err := r.queries.ListSkillsForProject(ctx, ListSkillsForProjectParams{
+		ProjectID:  projectID,
+		PublicOnly: r.publicOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSkills(dbSkills), nil
+}
+
+// DeleteProject soft-deletes a project: it is kept, with its links, but neither it nor its
+// achievements are read publicly any more. Updating it restores it.
+func (r *ProjectRepo) DeleteProject(ctx context.Context, id int32) error {
+	return r.queries.DeleteProject(ctx, id)
+}
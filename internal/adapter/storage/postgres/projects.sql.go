@@ -36,9 +36,9 @@ func (q *Queries) ClearSkillsFromProject(ctx context.Context, projectID int32) e
 }
 
 const createProject = `-- name: CreateProject :one
-INSERT INTO projects (name, description, start_date, end_date)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, description, start_date, end_date, created_at, updated_at
+INSERT INTO projects (name, description, start_date, end_date, visibility)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, name, description, start_date, end_date, created_at, updated_at, visibility, deleted_at
 `
 
 type CreateProjectParams struct {
@@ -46,6 +46,7 @@ type CreateProjectParams struct {
 	Description string      `json:"description"`
 	StartDate   pgtype.Date `json:"start_date"`
 	EndDate     pgtype.Date `json:"end_date"`
+	Visibility  string      `json:"visibility"`
 }
 
 func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error) {
@@ -54,6 +55,7 @@ func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) (P
 		arg.Description,
 		arg.StartDate,
 		arg.EndDate,
+		arg.Visibility,
 	)
 	var i Project
 	err := row.Scan(
@@ -64,12 +66,14 @@ func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) (P
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const deleteProject = `-- name: DeleteProject :exec
-DELETE FROM projects WHERE id = $1
+UPDATE projects SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) DeleteProject(ctx context.Context, id int32) error {
@@ -78,7 +82,7 @@ func (q *Queries) DeleteProject(ctx context.Context, id int32) error {
 }
 
 const getProject = `-- name: GetProject :one
-SELECT id, name, description, start_date, end_date, created_at, updated_at FROM projects WHERE id = $1
+SELECT id, name, description, start_date, end_date, created_at, updated_at, visibility, deleted_at FROM projects WHERE id = $1
 `
 
 func (q *Queries) GetProject(ctx context.Context, id int32) (Project, error) {
@@ -92,12 +96,14 @@ func (q *Queries) GetProject(ctx context.Context, id int32) (Project, error) {
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getProjectByName = `-- name: GetProjectByName :one
-SELECT id, name, description, start_date, end_date, created_at, updated_at FROM projects WHERE name = $1
+SELECT id, name, description, start_date, end_date, created_at, updated_at, visibility, deleted_at FROM projects WHERE name = $1
 `
 
 func (q *Queries) GetProjectByName(ctx context.Context, name string) (Project, error) {
@@ -111,6 +117,8 @@ func (q *Queries) GetProjectByName(ctx context.Context, name string) (Project, e
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -173,7 +181,7 @@ func (q *Queries) GetProjectWithSkills(ctx context.Context, id int32) ([]GetProj
 }
 
 const listExperiencesForProject = `-- name: ListExperiencesForProject :many
-SELECT e.id, e.company_name, e.job_title, e.location, e.start_date, e.end_date, e.description, e.highlights, e.created_at, e.updated_at FROM experiences e
+SELECT e.id, e.company_name, e.job_title, e.location, e.start_date, e.end_date, e.description, e.highlights, e.created_at, e.updated_at, e.visibility, e.deleted_at FROM experiences e
 JOIN experience_projects ep ON e.id = ep.experience_id
 WHERE ep.project_id = $1
 ORDER BY e.start_date DESC
@@ -200,6 +208,8 @@ func (q *Queries) ListExperiencesForProject(ctx context.Context, projectID int32
 			&i.Highlights,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -212,11 +222,13 @@ func (q *Queries) ListExperiencesForProject(ctx context.Context, projectID int32
 }
 
 const listProjects = `-- name: ListProjects :many
-SELECT id, name, description, start_date, end_date, created_at, updated_at FROM projects ORDER BY start_date DESC NULLS LAST
+SELECT id, name, description, start_date, end_date, created_at, updated_at, visibility, deleted_at FROM projects
+WHERE NOT $1::boolean OR (visibility = 'public' AND deleted_at IS NULL)
+ORDER BY start_date DESC NULLS LAST
 `
 
-func (q *Queries) ListProjects(ctx context.Context) ([]Project, error) {
-	rows, err := q.db.Query(ctx, listProjects)
+func (q *Queries) ListProjects(ctx context.Context, publicOnly bool) ([]Project, error) {
+	rows, err := q.db.Query(ctx, listProjects, publicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +244,8 @@ func (q *Queries) ListProjects(ctx context.Context) ([]Project, error) {
 			&i.EndDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -244,7 +258,7 @@ func (q *Queries) ListProjects(ctx context.Context) ([]Project, error) {
 }
 
 const listProjectsForSkill = `-- name: ListProjectsForSkill :many
-SELECT p.id, p.name, p.description, p.start_date, p.end_date, p.created_at, p.updated_at FROM projects p
+SELECT p.id, p.name, p.description, p.start_date, p.end_date, p.created_at, p.updated_at, p.visibility, p.deleted_at FROM projects p
 JOIN project_skills ps ON p.id = ps.project_id
 WHERE ps.skill_id = $1
 ORDER BY p.start_date DESC NULLS LAST
@@ -267,6 +281,8 @@ func (q *Queries) ListProjectsForSkill(ctx context.Context, skillID int32) ([]Pr
 			&i.EndDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -279,14 +295,20 @@ func (q *Queries) ListProjectsForSkill(ctx context.Context, skillID int32) ([]Pr
 }
 
 const listSkillsForProject = `-- name: ListSkillsForProject :many
-SELECT s.id, s.name, s.category, s.proficiency, s.logo_url FROM skills s
+SELECT s.id, s.name, s.category, s.proficiency, s.logo_url, s.created_at, s.updated_at, s.visibility, s.deleted_at FROM skills s
 JOIN project_skills ps ON s.id = ps.skill_id
 WHERE ps.project_id = $1
+  AND (NOT $2::boolean OR (s.visibility = 'public' AND s.deleted_at IS NULL))
 ORDER BY s.category, s.name
 `
 
-func (q *Queries) ListSkillsForProject(ctx context.Context, projectID int32) ([]Skill, error) {
-	rows, err := q.db.Query(ctx, listSkillsForProject, projectID)
+type ListSkillsForProjectParams struct {
+	ProjectID  int32 `json:"project_id"`
+	PublicOnly bool  `json:"public_only"`
+}
+
+func (q *Queries) ListSkillsForProject(ctx context.Context, arg ListSkillsForProjectParams) ([]Skill, error) {
+	rows, err := q.db.Query(ctx, listSkillsForProject, arg.ProjectID, arg.PublicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -300,6 +322,10 @@ func (q *Queries) ListSkillsForProject(ctx context.Context, projectID int32) ([]
 			&i.Category,
 			&i.Proficiency,
 			&i.LogoUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -327,9 +353,9 @@ func (q *Queries) RemoveSkillFromProject(ctx context.Context, arg RemoveSkillFro
 
 const updateProject = `-- name: UpdateProject :one
 UPDATE projects
-SET name = $2, description = $3, start_date = $4, end_date = $5, updated_at = NOW()
+SET name = $2, description = $3, start_date = $4, end_date = $5, visibility = $6, deleted_at = NULL, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, description, start_date, end_date, created_at, updated_at
+RETURNING id, name, description, start_date, end_date, created_at, updated_at, visibility, deleted_at
 `
 
 type UpdateProjectParams struct {
@@ -338,6 +364,7 @@ type UpdateProjectParams struct {
 	Description string      `json:"description"`
 	StartDate   pgtype.Date `json:"start_date"`
 	EndDate     pgtype.Date `json:"end_date"`
+	Visibility  string      `json:"visibility"`
 }
 
 func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) (Project, error) {
@@ -347,6 +374,7 @@ func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) (P
 		arg.Description,
 		arg.StartDate,
 		arg.EndDate,
+		arg.Visibility,
 	)
 	var i Project
 	err := row.Scan(
@@ -357,6 +385,8 @@ func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) (P
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
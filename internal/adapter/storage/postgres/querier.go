@@ -26,9 +26,11 @@ type Querier interface {
 	CreateExperience(ctx context.Context, arg CreateExperienceParams) (Experience, error)
 	CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error)
 	CreateSkill(ctx context.Context, arg CreateSkillParams) (Skill, error)
+	CreateSnapshot(ctx context.Context, arg CreateSnapshotParams) (CreateSnapshotRow, error)
 	DeleteAchievement(ctx context.Context, id int32) error
 	DeleteExperience(ctx context.Context, id int32) error
 	DeleteProject(ctx context.Context, id int32) error
+	DeleteSkill(ctx context.Context, id int32) error
 	GetAchievement(ctx context.Context, id int32) (Achievement, error)
 	GetAchievementByTitle(ctx context.Context, title string) (Achievement, error)
 	// Full achievement with skills (for display/RAG)
@@ -42,28 +44,36 @@ type Querier interface {
 	// Full project with skills (for display/RAG)
 	GetProjectWithSkills(ctx context.Context, id int32) ([]GetProjectWithSkillsRow, error)
 	GetSkillByName(ctx context.Context, name string) (Skill, error)
-	ListAchievements(ctx context.Context) ([]Achievement, error)
+	GetSnapshot(ctx context.Context, version int32) (CvSnapshot, error)
+	GetSnapshotByLabel(ctx context.Context, label pgtype.Text) (CvSnapshot, error)
+	// An achievement is only public if the experience and project it belongs to are too.
+	ListAchievements(ctx context.Context, publicOnly bool) ([]Achievement, error)
 	// Filter by context
 	ListAchievementsForExperience(ctx context.Context, experienceID pgtype.Int4) ([]Achievement, error)
 	ListAchievementsForProject(ctx context.Context, projectID pgtype.Int4) ([]Achievement, error)
 	ListAchievementsForSkill(ctx context.Context, skillID int32) ([]Achievement, error)
 	// RAG context query: Get all achievements with their related experience/project context
 	ListAchievementsWithContext(ctx context.Context) ([]ListAchievementsWithContextRow, error)
-	ListExperiences(ctx context.Context) ([]Experience, error)
+	// Newest first; cursor is the ID of the last event of the previous page.
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	ListExperiences(ctx context.Context, publicOnly bool) ([]Experience, error)
 	// Experience linking
 	ListExperiencesForProject(ctx context.Context, projectID int32) ([]Experience, error)
 	ListExperiencesForSkill(ctx context.Context, skillID int32) ([]Experience, error)
-	ListProjects(ctx context.Context) ([]Project, error)
+	ListProjects(ctx context.Context, publicOnly bool) ([]Project, error)
 	ListProjectsForExperience(ctx context.Context, experienceID int32) ([]Project, error)
 	ListProjectsForSkill(ctx context.Context, skillID int32) ([]Project, error)
-	ListSkills(ctx context.Context) ([]Skill, error)
-	ListSkillsForAchievement(ctx context.Context, achievementID int32) ([]Skill, error)
-	ListSkillsForExperience(ctx context.Context, experienceID int32) ([]Skill, error)
-	ListSkillsForProject(ctx context.Context, projectID int32) ([]Skill, error)
+	ListSkills(ctx context.Context, publicOnly bool) ([]Skill, error)
+	ListSkillsForAchievement(ctx context.Context, arg ListSkillsForAchievementParams) ([]Skill, error)
+	ListSkillsForExperience(ctx context.Context, arg ListSkillsForExperienceParams) ([]Skill, error)
+	ListSkillsForProject(ctx context.Context, arg ListSkillsForProjectParams) ([]Skill, error)
+	ListSnapshots(ctx context.Context) ([]ListSnapshotsRow, error)
 	RemoveProjectFromExperience(ctx context.Context, arg RemoveProjectFromExperienceParams) error
 	RemoveSkillFromAchievement(ctx context.Context, arg RemoveSkillFromAchievementParams) error
 	RemoveSkillFromExperience(ctx context.Context, arg RemoveSkillFromExperienceParams) error
 	RemoveSkillFromProject(ctx context.Context, arg RemoveSkillFromProjectParams) error
+	// Attributes the changes of the current transaction to an actor in audit_events.
+	SetAuditActor(ctx context.Context, actor string) error
 	UpdateAchievement(ctx context.Context, arg UpdateAchievementParams) (Achievement, error)
 	UpdateExperience(ctx context.Context, arg UpdateExperienceParams) (Experience, error)
 	UpdateProject(ctx context.Context, arg UpdateProjectParams) (Project, error)
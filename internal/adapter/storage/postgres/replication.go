@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+)
+
+// Replication compares the WAL positions of a primary and its streaming replica. The queries
+// use server functions only, so they are not generated.
+type Replication struct {
+	primary DBTX
+	replica DBTX
+}
+
+var _ port.Replication = (*Replication)(nil)
+
+// NewReplication creates a Replication for the replica of primary.
+func NewReplication(primary, replica DBTX) *Replication {
+	return &Replication{primary: primary, replica: replica}
+}
+
+// PrimaryPosition returns the current WAL position of the primary.
+func (r *Replication) PrimaryPosition(ctx context.Context) (string, error) {
+	var lsn string
+	if err := r.primary.QueryRow(ctx, "SELECT pg_current_wal_lsn()::text").Scan(&lsn); err != nil {
+		return "", fmt.Errorf("reading primary WAL position: %w", err)
+	}
+	return lsn, nil
+}
+
+// ReplayedTo reports whether the replica has replayed the WAL up to pos. A server that is not
+// in recovery, such as a primary configured as its own replica, is always caught up.
+func (r *Replication) ReplayedTo(ctx context.Context, pos string) (bool, error) {
+	var replayed bool
+	err := r.replica.QueryRow(ctx, "SELECT COALESCE(pg_last_wal_replay_lsn() >= $1::pg_lsn, true)", pos).Scan(&replayed)
+	if err != nil {
+		return false, fmt.Errorf("reading replica WAL position: %w", err)
+	}
+	return replayed, nil
+}
@@ -9,17 +9,20 @@ import (
 // SkillRepo represents a repository for managing skills, using the provided Queries struct.
 type SkillRepo struct {
 	queries *Queries
+	// publicOnly restricts reads to public records that are not deleted.
+	publicOnly bool
 }
 
 // NewSkillRepository creates a new instance of SkillRepo initialized with the provided Queries struct.
-func NewSkillRepository(q *Queries) *SkillRepo {
-	return &SkillRepo{queries: q}
+// With publicOnly, it only reads public skills that are not deleted.
+func NewSkillRepository(q *Queries, publicOnly bool) *SkillRepo {
+	return &SkillRepo{queries: q, publicOnly: publicOnly}
 }
 
 // GetSkills retrieves a list of domain.Skill objects by querying the database and converting them
 // to the appropriate model structure.
 func (r *SkillRepo) GetSkills(ctx context.Context) ([]domain.Skill, error) {
-	dbSkills, err := r.queries.ListSkills(ctx)
+	dbSkills, err := r.queries.ListSkills(ctx, r.publicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +46,7 @@ func (r *SkillRepo) CreateSkill(ctx context.Context, s domain.Skill) error {
 		Category:    s.Category,
 		Proficiency: pgtype.Int4{Int32: s.Proficiency, Valid: true},
 		LogoUrl:     pgtype.Text{String: s.LogoPath, Valid: s.LogoPath != ""},
+		Visibility:  string(s.Visibility),
 	})
 	return err
 }
@@ -54,6 +58,13 @@ func (r *SkillRepo) UpdateSkill(ctx context.Context, s domain.Skill) error {
 		Category:    s.Category,
 		Proficiency: pgtype.Int4{Int32: s.Proficiency, Valid: true},
 		LogoUrl:     pgtype.Text{String: s.LogoPath, Valid: s.LogoPath != ""},
+		Visibility:  string(s.Visibility),
 	})
 	return err
 }
+
+// DeleteSkill soft-deletes a skill: it is kept, with its links to experiences, projects and
+// achievements, but no longer read publicly. Updating it restores it.
+func (r *SkillRepo) DeleteSkill(ctx context.Context, id int32) error {
+	return r.queries.DeleteSkill(ctx, id)
+}
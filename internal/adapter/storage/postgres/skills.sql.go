@@ -12,9 +12,9 @@ import (
 )
 
 const createSkill = `-- name: CreateSkill :one
-INSERT INTO skills (name, category, proficiency, logo_url)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, category, proficiency, logo_url
+INSERT INTO skills (name, category, proficiency, logo_url, visibility)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, name, category, proficiency, logo_url, created_at, updated_at, visibility, deleted_at
 `
 
 type CreateSkillParams struct {
@@ -22,6 +22,7 @@ type CreateSkillParams struct {
 	Category    string      `json:"category"`
 	Proficiency pgtype.Int4 `json:"proficiency"`
 	LogoUrl     pgtype.Text `json:"logo_url"`
+	Visibility  string      `json:"visibility"`
 }
 
 func (q *Queries) CreateSkill(ctx context.Context, arg CreateSkillParams) (Skill, error) {
@@ -30,6 +31,7 @@ func (q *Queries) CreateSkill(ctx context.Context, arg CreateSkillParams) (Skill
 		arg.Category,
 		arg.Proficiency,
 		arg.LogoUrl,
+		arg.Visibility,
 	)
 	var i Skill
 	err := row.Scan(
@@ -38,12 +40,25 @@ func (q *Queries) CreateSkill(ctx context.Context, arg CreateSkillParams) (Skill
 		&i.Category,
 		&i.Proficiency,
 		&i.LogoUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const deleteSkill = `-- name: DeleteSkill :exec
+UPDATE skills SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteSkill(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteSkill, id)
+	return err
+}
+
 const getSkillByName = `-- name: GetSkillByName :one
-SELECT id, name, category, proficiency, logo_url FROM skills WHERE name = $1
+SELECT id, name, category, proficiency, logo_url, created_at, updated_at, visibility, deleted_at FROM skills WHERE name = $1
 `
 
 func (q *Queries) GetSkillByName(ctx context.Context, name string) (Skill, error) {
@@ -55,16 +70,22 @@ func (q *Queries) GetSkillByName(ctx context.Context, name string) (Skill, error
 		&i.Category,
 		&i.Proficiency,
 		&i.LogoUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const listSkills = `-- name: ListSkills :many
-SELECT id, name, category, proficiency, logo_url FROM skills ORDER BY category, name
+SELECT id, name, category, proficiency, logo_url, created_at, updated_at, visibility, deleted_at FROM skills
+WHERE NOT $1::boolean OR (visibility = 'public' AND deleted_at IS NULL)
+ORDER BY category, name
 `
 
-func (q *Queries) ListSkills(ctx context.Context) ([]Skill, error) {
-	rows, err := q.db.Query(ctx, listSkills)
+func (q *Queries) ListSkills(ctx context.Context, publicOnly bool) ([]Skill, error) {
+	rows, err := q.db.Query(ctx, listSkills, publicOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +99,10 @@ func (q *Queries) ListSkills(ctx context.Context) ([]Skill, error) {
 			&i.Category,
 			&i.Proficiency,
 			&i.LogoUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Visibility,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -90,8 +115,8 @@ func (q *Queries) ListSkills(ctx context.Context) ([]Skill, error) {
 }
 
 const updateSkill = `-- name: UpdateSkill :one
-UPDATE skills SET category = $2, proficiency = $3, logo_url = $4, updated_at = NOW()
-WHERE id = $1 RETURNING id, name, category, proficiency, logo_url
+UPDATE skills SET category = $2, proficiency = $3, logo_url = $4, visibility = $5, deleted_at = NULL, updated_at = NOW()
+WHERE id = $1 RETURNING id, name, category, proficiency, logo_url, created_at, updated_at, visibility, deleted_at
 `
 
 type UpdateSkillParams struct {
@@ -99,6 +124,7 @@ type UpdateSkillParams struct {
 	Category    string      `json:"category"`
 	Proficiency pgtype.Int4 `json:"proficiency"`
 	LogoUrl     pgtype.Text `json:"logo_url"`
+	Visibility  string      `json:"visibility"`
 }
 
 func (q *Queries) UpdateSkill(ctx context.Context, arg UpdateSkillParams) (Skill, error) {
@@ -107,6 +133,7 @@ func (q *Queries) UpdateSkill(ctx context.Context, arg UpdateSkillParams) (Skill
 		arg.Category,
 		arg.Proficiency,
 		arg.LogoUrl,
+		arg.Visibility,
 	)
 	var i Skill
 	err := row.Scan(
@@ -115,6 +142,10 @@ func (q *Queries) UpdateSkill(ctx context.Context, arg UpdateSkillParams) (Skill
 		&i.Category,
 		&i.Proficiency,
 		&i.LogoUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Visibility,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SnapshotRepo stores CV snapshots as opaque documents. The database rejects any change to a
+// snapshot once it is stored.
+type SnapshotRepo struct {
+	queries *Queries
+}
+
+// NewSnapshotRepository creates a new instance of SnapshotRepo initialized with the provided Queries struct.
+func NewSnapshotRepository(q *Queries) *SnapshotRepo {
+	return &SnapshotRepo{queries: q}
+}
+
+// CreateSnapshot stores document as the next snapshot version.
+func (r *SnapshotRepo) CreateSnapshot(ctx context.Context, label string, document []byte) (domain.SnapshotInfo, error) {
+	row, err := r.queries.CreateSnapshot(ctx, CreateSnapshotParams{
+		Label:   pgtype.Text{String: label, Valid: label != ""},
+		Content: document,
+	})
+	if err != nil {
+		return domain.SnapshotInfo{}, err
+	}
+	return toDomainSnapshotInfo(row.Version, row.Label, row.CreatedAt), nil
+}
+
+// ListSnapshots returns every snapshot, newest first, without its document.
+func (r *SnapshotRepo) ListSnapshots(ctx context.Context) ([]domain.SnapshotInfo, error) {
+	rows, err := r.queries.ListSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]domain.SnapshotInfo, len(rows))
+	for i, row := range rows {
+		snapshots[i] = toDomainSnapshotInfo(row.Version, row.Label, row.CreatedAt)
+	}
+	return snapshots, nil
+}
+
+// GetSnapshot returns the snapshot with the given version and its document, or
+// domain.ErrSnapshotNotFound.
+func (r *SnapshotRepo) GetSnapshot(ctx context.Context, version int32) (domain.SnapshotInfo, []byte, error) {
+	return snapshotResult(r.queries.GetSnapshot(ctx, version))
+}
+
+// GetSnapshotByLabel returns the snapshot with the given label and its document, or
+// domain.ErrSnapshotNotFound.
+func (r *SnapshotRepo) GetSnapshotByLabel(ctx context.Context, label string) (domain.SnapshotInfo, []byte, error) {
+	return snapshotResult(r.queries.GetSnapshotByLabel(ctx, pgtype.Text{String: label, Valid: true}))
+}
+
+func snapshotResult(s CvSnapshot, err error) (domain.SnapshotInfo, []byte, error) {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.SnapshotInfo{}, nil, domain.ErrSnapshotNotFound
+	}
+	if err != nil {
+		return domain.SnapshotInfo{}, nil, err
+	}
+	return toDomainSnapshotInfo(s.Version, s.Label, s.CreatedAt), s.Content, nil
+}
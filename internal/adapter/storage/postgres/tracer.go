@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/postgres"
+
+// QueryTracer is a pgx tracer starting a span for every query, named after its sqlc query.
+// Set it on the connection config so queries made outside the repositories are traced too.
+type QueryTracer struct {
+	tracer trace.Tracer
+}
+
+// NewQueryTracer creates a QueryTracer using the global tracer provider.
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{tracer: otel.Tracer(tracerName)}
+}
+
+// TraceQueryStart starts a client span for the query, as a child of the span in ctx.
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	name := queryName(data.SQL)
+	ctx, _ = t.tracer.Start(ctx, "db "+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system.name", "postgresql"),
+			attribute.String("db.operation.name", name),
+			attribute.String("db.query.text", data.SQL),
+		),
+	)
+	return ctx
+}
+
+// TraceQueryEnd ends the query span, recording the error unless the query only found no rows.
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int64("db.response.rows_affected", data.CommandTag.RowsAffected()))
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestQueryTracer(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status codes.Code
+	}{
+		{"success", nil, codes.Unset},
+		{"no rows", pgx.ErrNoRows, codes.Unset},
+		{"failure", errors.New("connection reset"), codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			tracer := &QueryTracer{tracer: provider.Tracer(tracerName)}
+
+			ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: listSkills})
+			tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 3"), Err: tt.err})
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			assert.Equal(t, "db ListSkills", spans[0].Name())
+			assert.Equal(t, tt.status, spans[0].Status().Code)
+		})
+	}
+}
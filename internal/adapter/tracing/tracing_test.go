@@ -0,0 +1,34 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetup(t *testing.T) {
+	tests := []struct {
+		exporter string
+		err      error
+	}{
+		{ExporterNone, nil},
+		{"", nil},
+		{ExporterStdout, nil},
+		{"jaeger", ErrUnknownExporter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.exporter, func(t *testing.T) {
+			shutdown, err := Setup(context.Background(), config.TracingConfig{Exporter: tt.exporter, ServiceName: "test", SampleRatio: 1})
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, shutdown(context.Background()))
+		})
+	}
+}
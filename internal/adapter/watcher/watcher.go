@@ -0,0 +1,78 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watcher calls a handler once file system activity in a set of directories has settled,
+// so that an editor saving several files, or one file in several steps, triggers a single reload.
+type Watcher struct {
+	fsw      *fsnotify.Watcher
+	debounce time.Duration
+	onChange func(ctx context.Context, changed []string)
+}
+
+// New creates a Watcher over the given directories. Directories are not watched recursively.
+func New(dirs []string, debounce time.Duration, onChange func(ctx context.Context, changed []string)) (*Watcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("creating file watcher: %w", err)
+	}
+	for _, dir := range dirs {
+		if err := fsw.Add(dir); err != nil {
+			_ = fsw.Close()
+			return nil, fmt.Errorf("watching %s: %w", dir, err)
+		}
+	}
+	return &Watcher{fsw: fsw, debounce: debounce, onChange: onChange}, nil
+}
+
+// Run delivers debounced changes until the context is cancelled, then closes the watcher.
+func (w *Watcher) Run(ctx context.Context) {
+	defer w.fsw.Close()
+
+	pending := make(map[string]struct{})
+	timer := time.NewTimer(w.debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				return
+			}
+			// Permission changes alone do not change content.
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			pending[filepath.Clean(event.Name)] = struct{}{}
+			timer.Reset(w.debounce)
+
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return
+			}
+			slog.Warn("file watcher error", "error", err)
+
+		case <-timer.C:
+			changed := make([]string, 0, len(pending))
+			for name := range pending {
+				changed = append(changed, name)
+			}
+			sort.Strings(changed)
+			clear(pending)
+			w.onChange(ctx, changed)
+		}
+	}
+}
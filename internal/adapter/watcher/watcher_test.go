@@ -0,0 +1,44 @@
+package watcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher_DebouncesBursts(t *testing.T) {
+	dir := t.TempDir()
+	calls := make(chan []string, 10)
+
+	w, err := New([]string{dir}, 100*time.Millisecond, func(_ context.Context, changed []string) {
+		calls <- changed
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Run(ctx)
+
+	for _, name := range []string{"skills.json", "projects.json", "skills.json"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("[]"), 0o600))
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	select {
+	case changed := <-calls:
+		assert.Equal(t, []string{filepath.Join(dir, "projects.json"), filepath.Join(dir, "skills.json")}, changed)
+	case <-time.After(2 * time.Second):
+		t.Fatal("no change delivered")
+	}
+
+	select {
+	case changed := <-calls:
+		t.Fatalf("unexpected second delivery: %v", changed)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
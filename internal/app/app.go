@@ -2,48 +2,234 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/cache"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/metrics"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/seedsource"
 	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/postgres"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/tracing"
 	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
 	"github.com/guillermoBallester/go-platform-cv/internal/service"
+	"github.com/guillermoBallester/go-platform-cv/sql"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
 )
 
+// tracingShutdownTimeout bounds flushing the spans still buffered when the app closes.
+const tracingShutdownTimeout = 5 * time.Second
+
 type App struct {
 	Cfg       *config.Config
-	CvService *service.CVService
-	SeedSvc   *service.SeedService
-	DB        *pgxpool.Pool
+	CvService port.CVReader
+	// Cache is the read cache behind CvService, and CacheListener flushes it whenever CV
+	// content changes. Both are nil when caching is disabled.
+	Cache         *service.CachedCVReader
+	CacheListener *postgres.Listener
+	SeedSvc       *service.SeedService
+	Migrator      *sql.Migrator
+	Health        *service.HealthService
+	Metrics       *metrics.Metrics
+	DB            *pgxpool.Pool
+	// Replica serves the CV reads when DB_REPLICA_URL is set, and is nil otherwise.
+	Replica *pgxpool.Pool
+	// Audit is the audit log, always read from the primary.
+	Audit port.AuditLog
+	// Snapshots takes and reads back CV snapshots, on the primary.
+	Snapshots *service.SnapshotService
+	// AdminCV reads the whole CV, hidden and deleted records included, from the primary
+	// and without the cache.
+	AdminCV port.CVReader
+
+	migrationDB     *pgxpool.Pool
+	cacheStore      port.Cache
+	shutdownTracing tracing.ShutdownFunc
+	closeOnce       sync.Once
 }
 
-func New(ctx context.Context, cfg *config.Config) (*App, error) {
-	dbPool, err := initDB(ctx, cfg)
+func New(ctx context.Context, cfg *config.Config) (_ *App, err error) {
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		return nil, fmt.Errorf("tracing: %w", err)
+	}
+	// On failure, whatever was set up so far is released, last first.
+	cleanups := []func(){func() { flushTracing(shutdownTracing) }}
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i]()
+		}
+	}()
+
+	dbPool, err := initDB(ctx, cfg.Database, cfg.Database.ConnectionString(), "primary")
 	if err != nil {
 		return nil, fmt.Errorf("db init: %w", err)
 	}
+	cleanups = append(cleanups, dbPool.Close)
+
+	var replicaPool *pgxpool.Pool
+	if cfg.Database.ReplicaURL != "" {
+		replicaPool, err = initReplica(ctx, cfg.Database)
+		if err != nil {
+			return nil, fmt.Errorf("replica db init: %w", err)
+		}
+		cleanups = append(cleanups, replicaPool.Close)
+	}
+	migrationDB, err := migrationPool(ctx, dbPool)
+	if err != nil {
+		return nil, fmt.Errorf("migration db init: %w", err)
+	}
+	cleanups = append(cleanups, migrationDB.Close)
+
+	seedSource, err := seedsource.New(cfg.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("seed source: %w", err)
+	}
+
+	migrator, err := sql.NewMigrator(migrationDB, cfg.Migrations.LockTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("migrator: %w", err)
+	}
+	cleanups = append(cleanups, func() { _ = migrator.Close() })
+
+	appMetrics := metrics.New()
+	appMetrics.RegisterPool(dbPool, "primary")
+
+	repos := postgres.NewRepositories(dbPool, appMetrics)
+	var cvSvc port.CVReader = service.NewCVService(repos.Public())
+	// The CV is only read, so with a replica it is read there, unless the replica is down or
+	// has not yet replayed the latest change.
+	var replicaReader *service.ReplicaCVReader
+	if replicaPool != nil {
+		appMetrics.RegisterPool(replicaPool, "replica")
+		replicaRepos := postgres.NewRepositories(replicaPool, appMetrics)
+		replicaReader = service.NewReplicaCVReader(cvSvc, service.NewCVService(replicaRepos.Public()),
+			postgres.NewReplication(dbPool, replicaPool))
+		cvSvc = replicaReader
+	}
+	var cvCache *service.CachedCVReader
+	var cacheListener *postgres.Listener
+	var cacheStore port.Cache
+	if cfg.Cache.Enabled {
+		cacheStore, err = newCacheStore(cfg.Cache)
+		if err != nil {
+			return nil, fmt.Errorf("cache: %w", err)
+		}
+		cvCache = service.NewCachedCVReader(cvSvc, cacheStore, cfg.Cache.TTL, appMetrics)
+		cvSvc = cvCache
+		cacheListener = postgres.NewListener(dbPool.Config().ConnConfig, postgres.CVChangedChannel, invalidateCache(cvCache, replicaReader))
+	}
 
-	repos := postgres.NewRepositories(dbPool)
-	cvSvc := service.NewCVService(*repos)
-	seedSvc := service.NewSeedService(*repos)
+	seedSvc := service.NewSeedService(dbPool, *repos, seedSource)
+	// The replica is left out: while it is down the CV is read from the primary.
+	health := service.NewHealthService(cfg.Server.HealthCheckTimeout,
+		service.HealthCheck{Name: "database", Check: pingDB(dbPool, "database")},
+		service.HealthCheck{Name: "migrations", Check: migrationsApplied(migrator)},
+	)
 
 	return &App{
-		Cfg:       cfg,
-		CvService: cvSvc,
-		SeedSvc:   seedSvc,
-		DB:        dbPool,
+		Cfg:           cfg,
+		CvService:     cvSvc,
+		Cache:         cvCache,
+		CacheListener: cacheListener,
+		SeedSvc:       seedSvc,
+		Migrator:      migrator,
+		Health:        health,
+		Metrics:       appMetrics,
+		DB:            dbPool,
+		Replica:       replicaPool,
+		Audit:         repos.Audit,
+		Snapshots:     service.NewSnapshotService(dbPool, *repos),
+		AdminCV:       service.NewCVService(repos),
+
+		migrationDB:     migrationDB,
+		cacheStore:      cacheStore,
+		shutdownTracing: shutdownTracing,
 	}, nil
 }
 
-func initDB(ctx context.Context, cfg *config.Config) (*pgxpool.Pool, error) {
-	poolConfig, err := pgxpool.ParseConfig(cfg.Database.ConnectionString())
-	if err != nil {
-		return nil, err
+// Close releases the migrator, the database connection pools and the cache, and flushes
+// pending spans. Calls after the first do nothing.
+func (a *App) Close() {
+	a.closeOnce.Do(a.close)
+}
+
+func (a *App) close() {
+	_ = a.Migrator.Close()
+	a.migrationDB.Close()
+	a.DB.Close()
+	if a.Replica != nil {
+		a.Replica.Close()
+	}
+	if c, ok := a.cacheStore.(io.Closer); ok {
+		_ = c.Close()
 	}
 
-	// Apply connection pool settings from config
-	poolConfig.MaxConns = int32(cfg.Database.MaxOpenConns)
-	poolConfig.MinConns = int32(cfg.Database.MaxIdleConns)
-	poolConfig.MaxConnLifetime = cfg.Database.ConnMaxLifetime
+	flushTracing(a.shutdownTracing)
+}
 
-	return pgxpool.NewWithConfig(ctx, poolConfig)
+// flushTracing exports the spans still buffered and stops the exporter.
+func flushTracing(shutdown tracing.ShutdownFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	if err := shutdown(ctx); err != nil {
+		slog.Warn("failed to flush traces", "error", err)
+	}
+}
+
+// newCacheStore creates the cache backend selected by CACHE_BACKEND.
+func newCacheStore(cfg config.CacheConfig) (port.Cache, error) {
+	if cfg.Backend == config.CacheBackendRedis {
+		return cache.NewRedis(cfg.RedisURL, cfg.RedisPrefix)
+	}
+	return cache.NewMemory(), nil
+}
+
+// invalidateCache flushes the read cache. With a replica, reads are first pinned to the primary
+// until the replica has the change, so the cache is not refilled with the old CV. A failure is
+// logged; entries then expire with their TTL.
+func invalidateCache(c *service.CachedCVReader, replica *service.ReplicaCVReader) func(context.Context) {
+	return func(ctx context.Context) {
+		if replica != nil {
+			if err := replica.Pin(ctx); err != nil {
+				slog.WarnContext(ctx, "pinning reads to the primary failed", "error", err)
+			}
+		}
+		if err := c.Invalidate(ctx); err != nil {
+			slog.WarnContext(ctx, "cache invalidation failed", "error", err)
+		}
+	}
+}
+
+// pingDB checks that the database accepts connections. The cause is logged rather than
+// reported, as health responses are public.
+func pingDB(db *pgxpool.Pool, check string) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if err := db.Ping(ctx); err != nil {
+			slog.WarnContext(ctx, "health check failed", "check", check, "error", err)
+			return errors.New(check + " unreachable")
+		}
+		return nil
+	}
+}
+
+// migrationsApplied checks that the schema is at the latest embedded migration.
+func migrationsApplied(migrator *sql.Migrator) func(context.Context) error {
+	return func(ctx context.Context) error {
+		state, err := migrator.State(ctx)
+		if err != nil {
+			slog.WarnContext(ctx, "health check failed", "check", "migrations", "error", err)
+			return errors.New("migration state unavailable")
+		}
+		if !state.UpToDate() {
+			return fmt.Errorf("schema at version %d, %d migrations pending", state.Current, len(state.Pending))
+		}
+		return nil
+	}
 }
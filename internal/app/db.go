@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/postgres"
+	"github.com/guillermoBallester/go-platform-cv/internal/config"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Backoff between the startup pings of the database, and the time each ping may take.
+const (
+	pingInitialBackoff = 250 * time.Millisecond
+	pingMaxBackoff     = 5 * time.Second
+	pingTimeout        = 5 * time.Second
+)
+
+// initDB creates a connection pool for connString with the pool settings of cfg, and waits
+// until the database answers. name tells the databases apart in logs.
+func initDB(ctx context.Context, cfg config.DatabaseConfig, connString, name string) (*pgxpool.Pool, error) {
+	pool, err := newPool(ctx, cfg, connString)
+	if err != nil {
+		return nil, err
+	}
+	if err := waitForDB(ctx, name, pool.Ping, cfg.ConnectMaxWait); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return pool, nil
+}
+
+// initReplica creates the replica's connection pool. Reads fall back to the primary while the
+// replica is down, so an unreachable replica is logged rather than failing startup.
+func initReplica(ctx context.Context, cfg config.DatabaseConfig) (*pgxpool.Pool, error) {
+	pool, err := newPool(ctx, cfg, cfg.ReplicaURL)
+	if err != nil {
+		return nil, err
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+	if err := pool.Ping(pingCtx); err != nil {
+		slog.WarnContext(ctx, "replica unreachable; reading from the primary", "error", err)
+	}
+	return pool, nil
+}
+
+// newPool creates a connection pool for connString with the pool settings of cfg. It does not
+// wait for a connection.
+func newPool(ctx context.Context, cfg config.DatabaseConfig, connString string) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply connection pool settings from config
+	poolConfig.MaxConns = int32(cfg.MaxOpenConns)
+	poolConfig.MinConns = int32(cfg.MaxIdleConns)
+	poolConfig.MaxConnLifetime = cfg.ConnMaxLifetime
+	poolConfig.MaxConnIdleTime = cfg.ConnMaxIdleTime
+	poolConfig.HealthCheckPeriod = cfg.HealthCheckPeriod
+	if cfg.StatementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+	poolConfig.ConnConfig.Tracer = postgres.NewQueryTracer()
+	return pgxpool.NewWithConfig(ctx, poolConfig)
+}
+
+// migrationPool opens a small pool on the primary for the migrator, without the statement
+// timeout: an index build or backfill must not be cancelled halfway through a deploy.
+func migrationPool(ctx context.Context, primary *pgxpool.Pool) (*pgxpool.Pool, error) {
+	poolConfig := primary.Config()
+	poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = "0"
+	// The migration lock holds a connection while the migrations run on a second one; a
+	// third serves the migration state checks meanwhile.
+	poolConfig.MaxConns = 3
+	poolConfig.MinConns = 0
+	return pgxpool.NewWithConfig(ctx, poolConfig)
+}
+
+// waitForDB pings until the database answers, doubling the wait between attempts up to
+// pingMaxBackoff. It gives up once the next attempt would start after maxWait; with a zero
+// maxWait it pings once.
+func waitForDB(ctx context.Context, name string, ping func(context.Context) error, maxWait time.Duration) error {
+	deadline := time.Now().Add(maxWait)
+	backoff := pingInitialBackoff
+	for attempt := 1; ; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+		err := ping(pingCtx)
+		cancel()
+		if err == nil {
+			if attempt > 1 {
+				slog.InfoContext(ctx, "database reachable", "pool", name, "attempts", attempt)
+			}
+			return nil
+		}
+		if time.Now().Add(backoff).After(deadline) {
+			return fmt.Errorf("%s database unreachable after %d attempts: %w", name, attempt, err)
+		}
+
+		slog.WarnContext(ctx, "database unreachable; retrying", "pool", name, "attempt", attempt, "retry_in", backoff, "error", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, pingMaxBackoff)
+	}
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForDB(t *testing.T) {
+	errDown := errors.New("connection refused")
+
+	tests := []struct {
+		name     string
+		failures int
+		maxWait  time.Duration
+		attempts int
+		err      bool
+	}{
+		{"up at once", 0, time.Minute, 1, false},
+		{"up after retries", 2, time.Minute, 3, false},
+		{"gives up after max wait", 100, 300 * time.Millisecond, 2, true},
+		{"zero max wait pings once", 100, 0, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			ping := func(context.Context) error {
+				attempts++
+				if attempts <= tt.failures {
+					return errDown
+				}
+				return nil
+			}
+
+			err := waitForDB(t.Context(), "primary", ping, tt.maxWait)
+			assert.Equal(t, tt.attempts, attempts)
+			if tt.err {
+				assert.ErrorIs(t, err, errDown)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWaitForDBStopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	err := waitForDB(ctx, "primary", func(context.Context) error { return errors.New("down") }, time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -3,6 +3,13 @@ package config
 import (
 	"errors"
 	"fmt"
+	"log/slog"
+	"net"
+	"net/netip"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/caarlos0/env/v11"
@@ -10,16 +17,32 @@ import (
 
 // Config holds all configuration for the application.
 // It is immutable after loading.
+//
+// Fields tagged secret:"true" can also be read from the file named by the variable with a
+// _FILE suffix, and are redacted by Print.
 type Config struct {
-	App      AppConfig
-	Server   ServerConfig
-	Database DatabaseConfig
+	App        AppConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	Migrations MigrationConfig
+	Seed       SeedConfig
+	Admin      AdminConfig
+	Metrics    MetricsConfig
+	Tracing    TracingConfig
+	Cache      CacheConfig
+	Theme      ThemeConfig
+	Security   SecurityConfig
+	RateLimit  RateLimitConfig
+	TLS        TLSConfig
 }
 
 // AppConfig holds application-level configuration.
 type AppConfig struct {
-	Env      string `env:"APP_ENV" envDefault:"production"`
-	SeedData bool   `env:"SEED_DATA" envDefault:"true"`
+	Env string `env:"APP_ENV" envDefault:"production"`
+	// LogLevel is the minimum level logged: debug, info, warn or error.
+	LogLevel slog.Level `env:"LOG_LEVEL" envDefault:"info"`
+	// ReloadDebounce is how long file changes must settle before a development hot reload.
+	ReloadDebounce time.Duration `env:"DEV_RELOAD_DEBOUNCE" envDefault:"300ms"`
 }
 
 // IsDevelopment returns true if running in development mode.
@@ -27,12 +50,92 @@ func (a AppConfig) IsDevelopment() bool {
 	return a.Env == "development"
 }
 
+func (a AppConfig) validate() error {
+	if a.Env != "development" && a.Env != "production" {
+		return fmt.Errorf("unknown APP_ENV %q: use development or production", a.Env)
+	}
+	if a.ReloadDebounce < 0 {
+		return errors.New("DEV_RELOAD_DEBOUNCE must not be negative")
+	}
+	return nil
+}
+
 // ServerConfig holds HTTP server configuration.
 type ServerConfig struct {
-	Port         string        `env:"PORT" envDefault:"8080"`
-	ReadTimeout  time.Duration `env:"SERVER_READ_TIMEOUT" envDefault:"10s"`
-	WriteTimeout time.Duration `env:"SERVER_WRITE_TIMEOUT" envDefault:"30s"`
-	IdleTimeout  time.Duration `env:"SERVER_IDLE_TIMEOUT" envDefault:"60s"`
+	Port              string        `env:"PORT" envDefault:"8080"`
+	ReadTimeout       time.Duration `env:"SERVER_READ_TIMEOUT" envDefault:"10s"`
+	ReadHeaderTimeout time.Duration `env:"SERVER_READ_HEADER_TIMEOUT" envDefault:"5s"`
+	WriteTimeout      time.Duration `env:"SERVER_WRITE_TIMEOUT" envDefault:"30s"`
+	IdleTimeout       time.Duration `env:"SERVER_IDLE_TIMEOUT" envDefault:"60s"`
+	MaxHeaderBytes    int           `env:"SERVER_MAX_HEADER_BYTES" envDefault:"1048576"`
+	// PreStopDelay keeps serving after readiness starts failing on shutdown, so load
+	// balancers see it and stop routing here before connections are drained.
+	PreStopDelay time.Duration `env:"SERVER_PRESTOP_DELAY" envDefault:"0s"`
+	// ShutdownTimeout bounds how long in-flight requests are drained on shutdown.
+	ShutdownTimeout time.Duration `env:"SERVER_SHUTDOWN_TIMEOUT" envDefault:"15s"`
+	// CacheControl is sent with the home page, API and export responses. They carry an ETag,
+	// so the default has clients revalidate every time and get 304 while nothing changed.
+	CacheControl string `env:"HTTP_CACHE_CONTROL" envDefault:"public, no-cache"`
+	// Maintenance answers every page and API request with 503 and a maintenance page, while
+	// health checks keep working.
+	Maintenance bool `env:"MAINTENANCE_MODE" envDefault:"false"`
+	// HealthCheckTimeout bounds each dependency check of the readiness endpoint.
+	HealthCheckTimeout time.Duration `env:"HEALTH_CHECK_TIMEOUT" envDefault:"2s"`
+	// TrustedProxies are the IPs and CIDRs of the proxies whose X-Forwarded-For is believed
+	// for the client IP. With none, the client IP is always the peer address.
+	TrustedProxies []string `env:"TRUSTED_PROXIES" envSeparator:","`
+	// MaxBodyBytes bounds the size of a request body.
+	MaxBodyBytes int64 `env:"SERVER_MAX_BODY_BYTES" envDefault:"1048576"`
+}
+
+func (s ServerConfig) validate() error {
+	if !validPort(s.Port) {
+		return fmt.Errorf("invalid PORT %q: use a number from 1 to 65535", s.Port)
+	}
+	for _, proxy := range s.TrustedProxies {
+		if _, err := netip.ParsePrefix(proxy); err == nil {
+			continue
+		}
+		if _, err := netip.ParseAddr(proxy); err != nil {
+			return fmt.Errorf("invalid TRUSTED_PROXIES entry %q: use an IP or a CIDR", proxy)
+		}
+	}
+	if s.MaxBodyBytes <= 0 {
+		return errors.New("SERVER_MAX_BODY_BYTES must be positive")
+	}
+	if s.MaxHeaderBytes <= 0 {
+		return errors.New("SERVER_MAX_HEADER_BYTES must be positive")
+	}
+	for name, d := range map[string]time.Duration{
+		"SERVER_READ_TIMEOUT":        s.ReadTimeout,
+		"SERVER_READ_HEADER_TIMEOUT": s.ReadHeaderTimeout,
+		"SERVER_WRITE_TIMEOUT":       s.WriteTimeout,
+		"SERVER_IDLE_TIMEOUT":        s.IdleTimeout,
+		"SERVER_PRESTOP_DELAY":       s.PreStopDelay,
+	} {
+		if d < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	if s.ShutdownTimeout <= 0 {
+		return errors.New("SERVER_SHUTDOWN_TIMEOUT must be positive")
+	}
+	if s.HealthCheckTimeout <= 0 {
+		return errors.New("HEALTH_CHECK_TIMEOUT must be positive")
+	}
+	return nil
+}
+
+// validPort returns true if port is a TCP port number.
+func validPort(port string) bool {
+	n, err := strconv.Atoi(port)
+	return err == nil && n >= 1 && n <= 65535
+}
+
+// validAddr returns true if addr is a listen address with a valid port, e.g. ":9090".
+func validAddr(addr string) bool {
+	_, port, err := net.SplitHostPort(addr)
+	return err == nil && validPort(port)
 }
 
 // Address returns the server address in the format ":port".
@@ -40,18 +143,288 @@ func (s ServerConfig) Address() string {
 	return ":" + s.Port
 }
 
+// MigrationConfig holds configuration for applying schema migrations.
+type MigrationConfig struct {
+	// OnStart applies pending migrations before serving. Disable it on replicas when a
+	// single instance or a deploy step runs "migrate up".
+	OnStart bool `env:"MIGRATE_ON_START" envDefault:"true"`
+	// LockTimeout is how long to wait for another instance holding the migration lock.
+	LockTimeout time.Duration `env:"MIGRATE_LOCK_TIMEOUT" envDefault:"5m"`
+}
+
+func (m MigrationConfig) validate() error {
+	if m.LockTimeout <= 0 {
+		return errors.New("MIGRATE_LOCK_TIMEOUT must be positive")
+	}
+	return nil
+}
+
+// AdminConfig holds configuration for the admin API.
+type AdminConfig struct {
+	// Token is the bearer token required by /api/admin routes. The admin API is disabled when empty.
+	Token string `env:"ADMIN_TOKEN" secret:"true"`
+}
+
+// MetricsConfig holds configuration for the Prometheus metrics endpoint.
+type MetricsConfig struct {
+	Enabled bool `env:"METRICS_ENABLED" envDefault:"true"`
+	// Addr serves /metrics on a separate listener, e.g. ":9090", so it can be kept off the
+	// public port. When empty, /metrics is served by the main server, to requests carrying
+	// the admin token.
+	Addr string `env:"METRICS_ADDR"`
+}
+
+func (m MetricsConfig) validate(server ServerConfig) error {
+	if !m.Enabled || m.Addr == "" {
+		return nil
+	}
+	if !validAddr(m.Addr) {
+		return fmt.Errorf("invalid METRICS_ADDR %q: use host:port or :port", m.Addr)
+	}
+	if _, port, _ := net.SplitHostPort(m.Addr); port == server.Port {
+		return errors.New("METRICS_ADDR must use another port than PORT")
+	}
+	return nil
+}
+
+// TracingConfig holds configuration for OpenTelemetry tracing.
+type TracingConfig struct {
+	// Exporter is "none", "stdout" or "otlp". The OTLP endpoint and headers are read from the
+	// standard OTEL_EXPORTER_OTLP_* variables.
+	Exporter    string  `env:"TRACING_EXPORTER" envDefault:"none"`
+	ServiceName string  `env:"OTEL_SERVICE_NAME" envDefault:"go-platform-cv"`
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" envDefault:"1"`
+}
+
+func (t TracingConfig) validate() error {
+	switch t.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		return fmt.Errorf("unknown TRACING_EXPORTER %q: use none, stdout or otlp", t.Exporter)
+	}
+	if t.ServiceName == "" {
+		return errors.New("OTEL_SERVICE_NAME must not be empty")
+	}
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		return errors.New("TRACING_SAMPLE_RATIO must be between 0 and 1")
+	}
+	return nil
+}
+
+// CacheConfig holds configuration for the CV read cache.
+type CacheConfig struct {
+	Enabled bool          `env:"CACHE_ENABLED" envDefault:"true"`
+	TTL     time.Duration `env:"CACHE_TTL" envDefault:"10m"`
+	// Backend is "memory", per instance, or "redis", shared by every instance.
+	Backend     string `env:"CACHE_BACKEND" envDefault:"memory"`
+	RedisURL    string `env:"CACHE_REDIS_URL" secret:"true"`
+	RedisPrefix string `env:"CACHE_REDIS_PREFIX" envDefault:"gocv:"`
+}
+
+// Cache backends.
+const (
+	CacheBackendMemory = "memory"
+	CacheBackendRedis  = "redis"
+)
+
+func (c CacheConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	switch c.Backend {
+	case CacheBackendMemory:
+	case CacheBackendRedis:
+		if c.RedisURL == "" {
+			return errors.New("CACHE_REDIS_URL is required with CACHE_BACKEND=redis")
+		}
+	default:
+		return fmt.Errorf("unknown CACHE_BACKEND %q: use memory or redis", c.Backend)
+	}
+	if c.TTL <= 0 {
+		return errors.New("CACHE_TTL must be positive")
+	}
+	return nil
+}
+
+// ThemeConfig holds configuration for the look of the HTML pages.
+type ThemeConfig struct {
+	// Name is the built-in theme pages use unless a request asks for another with ?theme=.
+	Name string `env:"THEME" envDefault:"default"`
+	// Dir holds templates, and optionally an assets directory, layered over every built-in
+	// theme: a file there replaces the built-in file of the same name.
+	Dir string `env:"THEME_DIR"`
+}
+
+func (t ThemeConfig) validate() error {
+	if t.Name == "" {
+		return errors.New("THEME must not be empty")
+	}
+	return nil
+}
+
+// TLSConfig holds configuration for serving HTTPS directly, without a proxy terminating TLS.
+type TLSConfig struct {
+	// CertFile and KeyFile are PEM files, reloaded when they change on disk.
+	CertFile string `env:"TLS_CERT_FILE"`
+	KeyFile  string `env:"TLS_KEY_FILE"`
+	// ACMEDomains has certificates for these domains obtained and renewed from an ACME CA,
+	// such as Let's Encrypt, instead of read from files.
+	ACMEDomains      []string `env:"TLS_ACME_DOMAINS" envSeparator:","`
+	ACMEEmail        string   `env:"TLS_ACME_EMAIL"`
+	ACMEDirectoryURL string   `env:"TLS_ACME_DIRECTORY_URL" envDefault:"https://acme-v02.api.letsencrypt.org/directory"`
+	// ACMECAFile holds extra PEM roots trusted for the ACME directory, e.g. a local Pebble's.
+	ACMECAFile string `env:"TLS_ACME_CA_FILE"`
+	// ACMECacheDir keeps the account key and certificates across restarts.
+	ACMECacheDir string `env:"TLS_ACME_CACHE_DIR" envDefault:"certs"`
+	// RedirectAddr serves plain HTTP on this address, e.g. ":80", redirecting to HTTPS and
+	// answering ACME HTTP-01 challenges.
+	RedirectAddr string `env:"TLS_REDIRECT_ADDR"`
+}
+
+// Enabled returns true if the server serves HTTPS.
+func (t TLSConfig) Enabled() bool {
+	return t.CertFile != "" || t.UsesACME()
+}
+
+// UsesACME returns true if certificates are obtained from an ACME CA.
+func (t TLSConfig) UsesACME() bool {
+	return len(t.ACMEDomains) > 0
+}
+
+func (t TLSConfig) validate() error {
+	if (t.CertFile == "") != (t.KeyFile == "") {
+		return errors.New("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+	if t.CertFile != "" && t.UsesACME() {
+		return errors.New("set either TLS_CERT_FILE or TLS_ACME_DOMAINS, not both")
+	}
+	if t.RedirectAddr != "" && !t.Enabled() {
+		return errors.New("TLS_REDIRECT_ADDR requires TLS_CERT_FILE or TLS_ACME_DOMAINS")
+	}
+	if t.RedirectAddr != "" && !validAddr(t.RedirectAddr) {
+		return fmt.Errorf("invalid TLS_REDIRECT_ADDR %q: use host:port or :port", t.RedirectAddr)
+	}
+	if t.UsesACME() && t.ACMECacheDir == "" {
+		return errors.New("TLS_ACME_CACHE_DIR is required with TLS_ACME_DOMAINS")
+	}
+	return nil
+}
+
+// SecurityConfig holds the security headers sent with every response. An empty value leaves
+// its header out.
+type SecurityConfig struct {
+	// CSP is the Content-Security-Policy. "{nonce}" is replaced by a fresh nonce per request,
+	// which themes set on inline scripts only; pages carrying it are sent with no-store.
+	CSP string `env:"SECURITY_CSP" envDefault:"default-src 'self'; script-src 'self' 'nonce-{nonce}' https://cdn.tailwindcss.com; style-src 'self' 'unsafe-inline' https://cdn.jsdelivr.net; img-src 'self' data: https:; object-src 'none'; base-uri 'self'; form-action 'self'; frame-ancestors 'none'"`
+	// HSTSMaxAge is the max-age of Strict-Transport-Security; zero leaves the header out.
+	// Browsers ignore it over plain HTTP.
+	HSTSMaxAge            time.Duration `env:"SECURITY_HSTS_MAX_AGE" envDefault:"8760h"`
+	HSTSIncludeSubdomains bool          `env:"SECURITY_HSTS_INCLUDE_SUBDOMAINS" envDefault:"false"`
+	FrameOptions          string        `env:"SECURITY_FRAME_OPTIONS" envDefault:"DENY"`
+	ReferrerPolicy        string        `env:"SECURITY_REFERRER_POLICY" envDefault:"strict-origin-when-cross-origin"`
+	PermissionsPolicy     string        `env:"SECURITY_PERMISSIONS_POLICY" envDefault:"camera=(), microphone=(), geolocation=(), payment=()"`
+}
+
+func (s SecurityConfig) validate() error {
+	if s.HSTSMaxAge < 0 {
+		return errors.New("SECURITY_HSTS_MAX_AGE must not be negative")
+	}
+	switch s.FrameOptions {
+	case "", "DENY", "SAMEORIGIN":
+	default:
+		return fmt.Errorf("invalid SECURITY_FRAME_OPTIONS %q: use DENY or SAMEORIGIN", s.FrameOptions)
+	}
+	return nil
+}
+
+// RateLimitConfig holds configuration for the per client IP rate limit.
+type RateLimitConfig struct {
+	Enabled bool `env:"RATE_LIMIT_ENABLED" envDefault:"true"`
+	// RPS is the sustained number of requests per second allowed from one IP.
+	RPS float64 `env:"RATE_LIMIT_RPS" envDefault:"10"`
+	// Burst is how many requests one IP may make at once before being limited to RPS.
+	Burst int `env:"RATE_LIMIT_BURST" envDefault:"40"`
+}
+
+func (r RateLimitConfig) validate() error {
+	if !r.Enabled {
+		return nil
+	}
+	if r.RPS <= 0 {
+		return errors.New("RATE_LIMIT_RPS must be positive")
+	}
+	if r.Burst < 1 {
+		return errors.New("RATE_LIMIT_BURST must be at least 1")
+	}
+	return nil
+}
+
+// SeedConfig holds configuration for seeding CV content at startup.
+type SeedConfig struct {
+	Enabled bool `env:"SEED_DATA" envDefault:"true"`
+	Strict  bool `env:"SEED_STRICT" envDefault:"false"`
+	Prune   bool `env:"SEED_PRUNE" envDefault:"false"`
+	DryRun  bool `env:"SEED_DRY_RUN" envDefault:"false"`
+	// Source is "embedded", a filesystem directory, or an http(s) base URL.
+	Source           string `env:"SEED_SOURCE" envDefault:"embedded"`
+	ChecksumFile     string `env:"SEED_CHECKSUM_FILE" envDefault:"SHA256SUMS"`
+	RequireChecksums bool   `env:"SEED_REQUIRE_CHECKSUMS" envDefault:"false"`
+}
+
+func (s SeedConfig) validate() error {
+	if s.IsRemote() && s.ChecksumFile == "" {
+		return errors.New("SEED_CHECKSUM_FILE is required with a remote SEED_SOURCE")
+	}
+	if s.RequireChecksums && s.ChecksumFile == "" {
+		return errors.New("SEED_CHECKSUM_FILE is required with SEED_REQUIRE_CHECKSUMS")
+	}
+	return nil
+}
+
+// IsEmbedded returns true if seed data is read from the files embedded in the binary.
+func (s SeedConfig) IsEmbedded() bool {
+	return s.Source == "" || s.Source == "embedded"
+}
+
+// Dir returns the seed directory, or "" if seed data is embedded or remote.
+func (s SeedConfig) Dir() string {
+	if s.IsEmbedded() || s.IsRemote() {
+		return ""
+	}
+	return s.Source
+}
+
+// IsRemote returns true if seed data is fetched over HTTP(S).
+func (s SeedConfig) IsRemote() bool {
+	return strings.HasPrefix(s.Source, "http://") || strings.HasPrefix(s.Source, "https://")
+}
+
 // DatabaseConfig holds database connection configuration.
 type DatabaseConfig struct {
-	URL             string        `env:"DATABASE_URL"`
+	URL             string        `env:"DATABASE_URL" secret:"true"`
 	Host            string        `env:"DB_HOST"`
 	Port            int           `env:"DB_PORT" envDefault:"5432"`
 	User            string        `env:"DB_USER"`
-	Password        string        `env:"DB_PASSWORD"`
+	Password        string        `env:"DB_PASSWORD" secret:"true"`
 	DBName          string        `env:"DB_NAME"`
 	SSLMode         string        `env:"DB_SSLMODE" envDefault:"disable"`
 	MaxOpenConns    int           `env:"DB_MAX_OPEN_CONNS" envDefault:"25"`
 	MaxIdleConns    int           `env:"DB_MAX_IDLE_CONNS" envDefault:"5"`
 	ConnMaxLifetime time.Duration `env:"DB_CONN_MAX_LIFETIME" envDefault:"5m"`
+	// ConnMaxIdleTime closes connections idle for longer, down to MaxIdleConns.
+	ConnMaxIdleTime time.Duration `env:"DB_CONN_MAX_IDLE_TIME" envDefault:"30m"`
+	// HealthCheckPeriod is how often idle connections are checked and expired ones closed.
+	HealthCheckPeriod time.Duration `env:"DB_HEALTH_CHECK_PERIOD" envDefault:"1m"`
+	// StatementTimeout makes Postgres cancel any statement running longer. Migrations run
+	// without it. Zero leaves the server's setting.
+	StatementTimeout time.Duration `env:"DB_STATEMENT_TIMEOUT" envDefault:"30s"`
+	// ConnectMaxWait is how long startup retries, with exponential backoff, until the
+	// database answers. Zero tries once.
+	ConnectMaxWait time.Duration `env:"DB_CONNECT_MAX_WAIT" envDefault:"30s"`
+	// ReplicaURL routes the CV reads to a read replica. They fall back to the primary while
+	// the replica is down or has not replayed the latest change. Writes, seeding and
+	// migrations always use the primary.
+	ReplicaURL string `env:"DB_REPLICA_URL" secret:"true"`
 }
 
 // ConnectionString returns the database connection string.
@@ -67,18 +440,52 @@ func (d DatabaseConfig) ConnectionString() string {
 	)
 }
 
+// sslModes are the sslmode values Postgres accepts.
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// validate checks the pool settings and connection components. Whether a connection is
+// configured at all depends on the environment; see validateProduction.
+func (d DatabaseConfig) validate() error {
+	if d.Port < 1 || d.Port > 65535 {
+		return fmt.Errorf("invalid DB_PORT %d: use a number from 1 to 65535", d.Port)
+	}
+	if !slices.Contains(sslModes, d.SSLMode) {
+		return fmt.Errorf("unknown DB_SSLMODE %q: use one of %v", d.SSLMode, sslModes)
+	}
+	if d.MaxOpenConns < 1 {
+		return errors.New("DB_MAX_OPEN_CONNS must be at least 1")
+	}
+	// MaxIdleConns is the pool's MinConns, which may not exceed its MaxConns.
+	if d.MaxIdleConns < 0 || d.MaxIdleConns > d.MaxOpenConns {
+		return fmt.Errorf("DB_MAX_IDLE_CONNS must be between 0 and DB_MAX_OPEN_CONNS (%d)", d.MaxOpenConns)
+	}
+	for name, v := range map[string]time.Duration{
+		"DB_CONN_MAX_LIFETIME":  d.ConnMaxLifetime,
+		"DB_CONN_MAX_IDLE_TIME": d.ConnMaxIdleTime,
+		"DB_STATEMENT_TIMEOUT":  d.StatementTimeout,
+		"DB_CONNECT_MAX_WAIT":   d.ConnectMaxWait,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	if d.HealthCheckPeriod <= 0 {
+		return errors.New("DB_HEALTH_CHECK_PERIOD must be positive")
+	}
+	return nil
+}
+
 // hasComponents returns true if individual database components are configured.
 func (d DatabaseConfig) hasComponents() bool {
 	return d.Host != "" && d.User != "" && d.DBName != ""
 }
 
-// Load parses environment variables and returns a validated Config.
+// Load parses the config file and environment variables and returns a validated Config.
 // It fails fast if required configuration is missing.
 func Load() (*Config, error) {
-	cfg := &Config{}
-
-	if err := env.Parse(cfg); err != nil {
-		return nil, fmt.Errorf("parsing config: %w", err)
+	cfg, err := Parse()
+	if err != nil {
+		return nil, err
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -88,14 +495,48 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
-// Validate checks that all required configuration is present.
+// Parse parses the config file named by CONFIG_FILE, if set, and environment variables,
+// which take precedence over the file, without validating them. It is meant for commands that
+// never connect to the database, such as seed validation in CI.
+func Parse() (*Config, error) {
+	environ, err := environment(env.ToMap(os.Environ()))
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	cfg := &Config{}
+	if err := env.ParseWithOptions(cfg, env.Options{Environment: environ}); err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// Validate checks every section and that all required configuration is present, reporting
+// every problem found rather than only the first.
 // In development mode, local defaults are allowed.
 // In production mode, database configuration is strictly required.
 func (c *Config) Validate() error {
+	errs := []error{
+		c.App.validate(),
+		c.Server.validate(),
+		c.Database.validate(),
+		c.Migrations.validate(),
+		c.Seed.validate(),
+		c.Metrics.validate(c.Server),
+		c.Tracing.validate(),
+		c.Cache.validate(),
+		c.Theme.validate(),
+		c.Security.validate(),
+		c.RateLimit.validate(),
+		c.TLS.validate(),
+	}
 	if c.App.IsDevelopment() {
-		return c.validateDevelopment()
+		errs = append(errs, c.validateDevelopment())
+	} else {
+		errs = append(errs, c.validateProduction())
 	}
-	return c.validateProduction()
+	return errors.Join(errs...)
 }
 
 func (c *Config) validateDevelopment() error {
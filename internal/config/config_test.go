@@ -0,0 +1,199 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestParseLayersFileUnderEnvironment(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{"yaml", "cv.yaml", "port: 9000\nserver:\n  read_timeout: 3s\n  write_timeout: 4s\ntrusted_proxies: [10.0.0.0/8, 127.0.0.1]\n"},
+		{"toml", "cv.toml", "port = 9000\ntrusted_proxies = [\"10.0.0.0/8\", \"127.0.0.1\"]\n[server]\nread_timeout = \"3s\"\nwrite_timeout = \"4s\"\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CONFIG_FILE", writeFile(t, tt.file, tt.content))
+			t.Setenv("SERVER_WRITE_TIMEOUT", "5s")
+
+			cfg, err := Parse()
+			require.NoError(t, err)
+			assert.Equal(t, "9000", cfg.Server.Port)
+			assert.Equal(t, 3*time.Second, cfg.Server.ReadTimeout)
+			assert.Equal(t, 5*time.Second, cfg.Server.WriteTimeout, "the environment wins over the file")
+			assert.Equal(t, []string{"10.0.0.0/8", "127.0.0.1"}, cfg.Server.TrustedProxies)
+			assert.Equal(t, 60*time.Second, cfg.Server.IdleTimeout, "defaults fill the rest")
+		})
+	}
+}
+
+func TestParseRejectsBadFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected string
+	}{
+		{"unknown key", "cv.yaml", "server:\n  read_timout: 3s\n", "unknown key server_read_timout"},
+		{"unknown format", "cv.json", "{}", "unsupported format"},
+		{"malformed", "cv.toml", "port = \n", "config file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CONFIG_FILE", writeFile(t, tt.file, tt.content))
+
+			_, err := Parse()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
+
+func TestParseReadsSecretFiles(t *testing.T) {
+	t.Run("from environment", func(t *testing.T) {
+		t.Setenv("DB_PASSWORD_FILE", writeFile(t, "password", "s3cret\n"))
+
+		cfg, err := Parse()
+		require.NoError(t, err)
+		assert.Equal(t, "s3cret", cfg.Database.Password)
+	})
+
+	t.Run("from config file", func(t *testing.T) {
+		secret := writeFile(t, "token", "admin-token")
+		t.Setenv("CONFIG_FILE", writeFile(t, "cv.yaml", "admin_token_file: "+secret+"\n"))
+
+		cfg, err := Parse()
+		require.NoError(t, err)
+		assert.Equal(t, "admin-token", cfg.Admin.Token)
+	})
+
+	t.Run("both set", func(t *testing.T) {
+		t.Setenv("DB_PASSWORD", "inline")
+		t.Setenv("DB_PASSWORD_FILE", writeFile(t, "password", "s3cret"))
+
+		_, err := Parse()
+		assert.ErrorContains(t, err, "set either DB_PASSWORD or DB_PASSWORD_FILE")
+	})
+
+	t.Run("both set in config file", func(t *testing.T) {
+		secret := writeFile(t, "password", "s3cret")
+		t.Setenv("CONFIG_FILE", writeFile(t, "cv.yaml", "db_password: inline\ndb_password_file: "+secret+"\n"))
+
+		_, err := Parse()
+		assert.ErrorContains(t, err, "set either DB_PASSWORD or DB_PASSWORD_FILE")
+	})
+
+	t.Run("environment over config file", func(t *testing.T) {
+		missing := filepath.Join(t.TempDir(), "missing")
+		t.Setenv("CONFIG_FILE", writeFile(t, "cv.yaml", "db_password_file: "+missing+"\nadmin_token: from-file\n"))
+		t.Setenv("DB_PASSWORD", "from-env")
+		t.Setenv("ADMIN_TOKEN_FILE", writeFile(t, "token", "from-secret"))
+
+		cfg, err := Parse()
+		require.NoError(t, err)
+		assert.Equal(t, "from-env", cfg.Database.Password, "the file's secret file is not read")
+		assert.Equal(t, "from-secret", cfg.Admin.Token)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Setenv("DB_PASSWORD_FILE", filepath.Join(t.TempDir(), "missing"))
+
+		_, err := Parse()
+		assert.ErrorContains(t, err, "DB_PASSWORD_FILE")
+	})
+}
+
+func validConfig(t *testing.T) *Config {
+	t.Helper()
+	t.Setenv("DATABASE_URL", "postgres://localhost/gocv")
+	cfg, err := Parse()
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+	return cfg
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(*Config)
+		expected string
+	}{
+		{"bad app env", func(c *Config) { c.App.Env = "staging" }, "unknown APP_ENV"},
+		{"bad port", func(c *Config) { c.Server.Port = "http" }, "invalid PORT"},
+		{"port out of range", func(c *Config) { c.Server.Port = "70000" }, "invalid PORT"},
+		{"negative timeout", func(c *Config) { c.Server.ReadTimeout = -time.Second }, "SERVER_READ_TIMEOUT must not be negative"},
+		{"min conns above max", func(c *Config) { c.Database.MaxIdleConns = 30 }, "DB_MAX_IDLE_CONNS must be between 0 and DB_MAX_OPEN_CONNS"},
+		{"no max conns", func(c *Config) { c.Database.MaxOpenConns = 0 }, "DB_MAX_OPEN_CONNS must be at least 1"},
+		{"bad ssl mode", func(c *Config) { c.Database.SSLMode = "on" }, "unknown DB_SSLMODE"},
+		{"no lock timeout", func(c *Config) { c.Migrations.LockTimeout = 0 }, "MIGRATE_LOCK_TIMEOUT must be positive"},
+		{"remote seeds without checksums", func(c *Config) {
+			c.Seed.Source = "https://example.com/cv/"
+			c.Seed.ChecksumFile = ""
+		}, "SEED_CHECKSUM_FILE is required"},
+		{"metrics on the server port", func(c *Config) { c.Metrics.Addr = ":8080" }, "METRICS_ADDR must use another port"},
+		{"bad metrics addr", func(c *Config) { c.Metrics.Addr = "9090" }, "invalid METRICS_ADDR"},
+		{"bad exporter", func(c *Config) { c.Tracing.Exporter = "jaeger" }, "unknown TRACING_EXPORTER"},
+		{"bad sample ratio", func(c *Config) { c.Tracing.SampleRatio = 2 }, "TRACING_SAMPLE_RATIO"},
+		{"no theme", func(c *Config) { c.Theme.Name = "" }, "THEME must not be empty"},
+		{"bad frame options", func(c *Config) { c.Security.FrameOptions = "ALLOW" }, "invalid SECURITY_FRAME_OPTIONS"},
+		{"bad redirect addr", func(c *Config) {
+			c.TLS.CertFile, c.TLS.KeyFile = "tls.crt", "tls.key"
+			c.TLS.RedirectAddr = "80"
+		}, "invalid TLS_REDIRECT_ADDR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig(t)
+			tt.mutate(cfg)
+			assert.ErrorContains(t, cfg.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestValidateReportsEveryProblem(t *testing.T) {
+	cfg := validConfig(t)
+	cfg.Server.Port = "0"
+	cfg.Tracing.SampleRatio = -1
+
+	err := cfg.Validate()
+	assert.ErrorContains(t, err, "invalid PORT")
+	assert.ErrorContains(t, err, "TRACING_SAMPLE_RATIO")
+}
+
+func TestPrintRedactsSecrets(t *testing.T) {
+	cfg := validConfig(t)
+	cfg.Database.Password = "s3cret"
+	cfg.Admin.Token = "admin-token"
+	cfg.Server.TrustedProxies = []string{"10.0.0.0/8", "127.0.0.1"}
+
+	var out strings.Builder
+	require.NoError(t, cfg.Print(&out))
+
+	printed := out.String()
+	assert.NotContains(t, printed, "s3cret")
+	assert.NotContains(t, printed, "admin-token")
+	assert.NotContains(t, printed, "postgres://")
+	assert.Contains(t, printed, "DB_PASSWORD=[redacted]\n")
+	assert.Contains(t, printed, "CACHE_REDIS_URL=\n", "empty secrets are shown as empty")
+	assert.Contains(t, printed, "# Server\nPORT=8080\n")
+	assert.Contains(t, printed, "TRUSTED_PROXIES=10.0.0.0/8,127.0.0.1\n")
+}
@@ -0,0 +1,246 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// fileSuffix marks a variable holding the path of a file to read a secret from, e.g.
+// DB_PASSWORD_FILE for DB_PASSWORD, as Docker and Kubernetes secrets are mounted.
+const fileSuffix = "_FILE"
+
+// environment merges the variables of the config file named by CONFIG_FILE, if any, under
+// environ, so the environment wins, and reads the secrets given as files. A secret set in the
+// environment, as NAME or NAME_FILE, replaces both forms of it in the file.
+func environment(environ map[string]string) (map[string]string, error) {
+	var layers []map[string]string
+	if path := environ["CONFIG_FILE"]; path != "" {
+		vars, err := readFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("config file %s: %w", path, err)
+		}
+		layers = append(layers, vars)
+	}
+	layers = append(layers, environ)
+
+	secrets := secretNames()
+	merged := make(map[string]string)
+	for _, layer := range layers {
+		for _, name := range secrets {
+			_, plain := layer[name]
+			_, file := layer[name+fileSuffix]
+			if plain && file {
+				return nil, fmt.Errorf("set either %s or %s%s, not both", name, name, fileSuffix)
+			}
+			if plain || file {
+				delete(merged, name)
+				delete(merged, name+fileSuffix)
+			}
+		}
+		for name, value := range layer {
+			merged[name] = value
+		}
+	}
+
+	for _, name := range secrets {
+		path, ok := merged[name+fileSuffix]
+		if !ok {
+			continue
+		}
+		secret, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s%s: %w", name, fileSuffix, err)
+		}
+		merged[name] = strings.TrimRight(string(secret), "\r\n")
+	}
+	return merged, nil
+}
+
+// readFile reads a YAML or TOML config file, chosen by its extension, into variables. Keys are
+// the names of the environment variables, in any case, and nested tables join their key to the
+// keys inside with an underscore: server: {read_timeout: 10s} sets SERVER_READ_TIMEOUT. Lists
+// are joined with commas. Unknown keys are an error, so a typo does not go unnoticed.
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var tree map[string]any
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &tree)
+	case ".toml":
+		err = toml.NewDecoder(bytes.NewReader(data)).Decode(&tree)
+	default:
+		return nil, fmt.Errorf("unsupported format %q: use .yaml, .yml or .toml", ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]string)
+	if err := flatten(vars, "", tree); err != nil {
+		return nil, err
+	}
+	known := knownNames()
+	for name := range vars {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown key %s", strings.ToLower(name))
+		}
+	}
+	return vars, nil
+}
+
+func flatten(vars map[string]string, prefix string, tree map[string]any) error {
+	for key, value := range tree {
+		name := strings.ToUpper(key)
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+		if table, ok := value.(map[string]any); ok {
+			if err := flatten(vars, name, table); err != nil {
+				return err
+			}
+			continue
+		}
+		s, err := formatValue(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", strings.ToLower(name), err)
+		}
+		vars[name] = s
+	}
+	return nil
+}
+
+func formatValue(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool, int, int64, uint64:
+		return fmt.Sprint(v), nil
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			s, err := formatValue(item)
+			if err != nil {
+				return "", err
+			}
+			items[i] = s
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value of type %T", value)
+	}
+}
+
+// setting is a field of a config section and the variable that sets it.
+type setting struct {
+	name   string
+	secret bool
+	value  reflect.Value
+}
+
+// settings returns the settings of every section of c, in declaration order.
+func (c *Config) settings() [][]setting {
+	sections := reflect.ValueOf(c).Elem()
+	all := make([][]setting, 0, sections.NumField())
+	for i := range sections.NumField() {
+		section := sections.Field(i)
+		var fields []setting
+		for j := range section.NumField() {
+			field := section.Type().Field(j)
+			name, _, _ := strings.Cut(field.Tag.Get("env"), ",")
+			if name == "" {
+				continue
+			}
+			fields = append(fields, setting{
+				name:   name,
+				secret: field.Tag.Get("secret") == "true",
+				value:  section.Field(j),
+			})
+		}
+		all = append(all, fields)
+	}
+	return all
+}
+
+// knownNames returns every variable a config file may set.
+func knownNames() map[string]bool {
+	known := make(map[string]bool)
+	for _, section := range new(Config).settings() {
+		for _, s := range section {
+			known[s.name] = true
+			if s.secret {
+				known[s.name+fileSuffix] = true
+			}
+		}
+	}
+	return known
+}
+
+// secretNames returns the variables that may be read from a file.
+func secretNames() []string {
+	var names []string
+	for _, section := range new(Config).settings() {
+		for _, s := range section {
+			if s.secret {
+				names = append(names, s.name)
+			}
+		}
+	}
+	return names
+}
+
+// redacted replaces the value of a secret in Print.
+const redacted = "[redacted]"
+
+// Print writes the effective configuration as environment variables, one section after the
+// other, with the values of secrets replaced by a placeholder.
+func (c *Config) Print(w io.Writer) error {
+	sections := reflect.TypeOf(*c)
+	for i, section := range c.settings() {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "# %s\n", sections.Field(i).Name); err != nil {
+			return err
+		}
+		for _, s := range section {
+			value := printValue(s.value)
+			if s.secret && value != "" {
+				value = redacted
+			}
+			if _, err := fmt.Fprintf(w, "%s=%s\n", s.name, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func printValue(v reflect.Value) string {
+	if v.Kind() == reflect.Slice {
+		items := make([]string, v.Len())
+		for i := range v.Len() {
+			items[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -16,6 +16,8 @@ type Achievement struct {
 	Skills       []Skill // Related skills
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	Visibility   Visibility
+	DeletedAt    *time.Time // nil unless soft-deleted
 }
 
 // NewAchievement creates a validated Achievement. Returns error if validation fails.
@@ -28,6 +30,7 @@ func NewAchievement(title, description string, date *time.Time, experienceID, pr
 		ProjectID:    projectID,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
+		Visibility:   VisibilityPublic,
 	}
 
 	if err := a.Validate(); err != nil {
@@ -45,9 +48,17 @@ func (a Achievement) Validate() error {
 	if a.Description == "" {
 		return ErrEmptyDescription
 	}
+	if !a.Visibility.Valid() {
+		return ErrInvalidVisibility
+	}
 	return nil
 }
 
+// IsPublic returns true if the achievement is public and not deleted.
+func (a Achievement) IsPublic() bool {
+	return a.Visibility == VisibilityPublic && a.DeletedAt == nil
+}
+
 // HasContext returns true if the achievement is linked to an experience or project.
 func (a Achievement) HasContext() bool {
 	return a.ExperienceID != nil || a.ProjectID != nil
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ActorSeed is the actor of the changes made by seed runs.
+const ActorSeed = "seed"
+
+// Audited entities, as AuditEvent.Entity names them. The events of a link carry the ID of the
+// record it belongs to, the first one named.
+const (
+	EntitySkill             = "skill"
+	EntityExperience        = "experience"
+	EntityAchievement       = "achievement"
+	EntityProject           = "project"
+	EntityExperienceSkill   = "experience_skill"
+	EntityProjectSkill      = "project_skill"
+	EntityAchievementSkill  = "achievement_skill"
+	EntityExperienceProject = "experience_project"
+)
+
+// AuditEntities lists the entities whose changes are audited.
+var AuditEntities = []string{
+	EntitySkill, EntityExperience, EntityAchievement, EntityProject,
+	EntityExperienceSkill, EntityProjectSkill, EntityAchievementSkill, EntityExperienceProject,
+}
+
+// Audit actions.
+const (
+	AuditCreate = "create"
+	AuditUpdate = "update"
+	AuditDelete = "delete"
+)
+
+// AuditEvent records a change to a CV entity: who made it, and the fields it changed.
+type AuditEvent struct {
+	ID         int64     `json:"id"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Actor      string    `json:"actor"`
+	Entity     string    `json:"entity"`
+	EntityID   int32     `json:"entity_id"`
+	Action     string    `json:"action"`
+	// Before and After hold the changed fields as JSON objects. Before is empty for a create,
+	// and After for a delete.
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// AuditFilter selects audit events, newest first. Zero fields do not filter.
+type AuditFilter struct {
+	Entity string
+	// Since and Until bound the time of the events, Since included and Until excluded.
+	Since time.Time
+	Until time.Time
+	// Before only returns events older than the event with this ID, to page through them.
+	Before int64
+	Limit  int
+}
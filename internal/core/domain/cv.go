@@ -0,0 +1,88 @@
+package domain
+
+import "time"
+
+// CV aggregates all the content of a CV.
+type CV struct {
+	Skills       []Skill
+	Experiences  []Experience
+	Achievements []Achievement
+	Projects     []Project
+}
+
+// LastModified returns the latest update time of any skill, experience, achievement or
+// project, or the zero time if the CV is empty.
+func (cv CV) LastModified() time.Time {
+	var latest time.Time
+	for _, s := range cv.Skills {
+		latest = laterOf(latest, s.UpdatedAt)
+	}
+	for _, e := range cv.Experiences {
+		latest = laterOf(latest, e.UpdatedAt)
+	}
+	for _, a := range cv.Achievements {
+		latest = laterOf(latest, a.UpdatedAt)
+	}
+	for _, p := range cv.Projects {
+		latest = laterOf(latest, p.UpdatedAt)
+	}
+	return latest
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// Public returns the part of the CV anyone may see: the public entities that are not deleted,
+// linked only to public skills. Like the public database queries, it also leaves out the
+// achievements of hidden experiences and projects.
+func (cv CV) Public() CV {
+	var public CV
+	for _, s := range cv.Skills {
+		if s.IsPublic() {
+			public.Skills = append(public.Skills, s)
+		}
+	}
+
+	hiddenExperiences := make(map[int32]bool)
+	for _, e := range cv.Experiences {
+		if !e.IsPublic() {
+			hiddenExperiences[e.ID] = true
+			continue
+		}
+		e.Skills = publicSkills(e.Skills)
+		public.Experiences = append(public.Experiences, e)
+	}
+	hiddenProjects := make(map[int32]bool)
+	for _, p := range cv.Projects {
+		if !p.IsPublic() {
+			hiddenProjects[p.ID] = true
+			continue
+		}
+		p.Skills = publicSkills(p.Skills)
+		public.Projects = append(public.Projects, p)
+	}
+	for _, a := range cv.Achievements {
+		if !a.IsPublic() ||
+			(a.ExperienceID != nil && hiddenExperiences[*a.ExperienceID]) ||
+			(a.ProjectID != nil && hiddenProjects[*a.ProjectID]) {
+			continue
+		}
+		a.Skills = publicSkills(a.Skills)
+		public.Achievements = append(public.Achievements, a)
+	}
+	return public
+}
+
+func publicSkills(skills []Skill) []Skill {
+	var public []Skill
+	for _, s := range skills {
+		if s.IsPublic() {
+			public = append(public, s)
+		}
+	}
+	return public
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCVLastModified(t *testing.T) {
+	older := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		cv       CV
+		expected time.Time
+	}{
+		{"empty", CV{}, time.Time{}},
+		{"skill", CV{Skills: []Skill{{UpdatedAt: newer}}, Experiences: []Experience{{UpdatedAt: older}}}, newer},
+		{"project", CV{Experiences: []Experience{{UpdatedAt: older}}, Projects: []Project{{UpdatedAt: newer}}}, newer},
+		{"achievement", CV{Achievements: []Achievement{{UpdatedAt: newer}, {UpdatedAt: older}}}, newer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.cv.LastModified())
+		})
+	}
+}
+
+func TestCVPublic(t *testing.T) {
+	deleted := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	id := func(n int32) *int32 { return &n }
+	goSkill := Skill{ID: 1, Name: "Go", Visibility: VisibilityPublic}
+	oldSkill := Skill{ID: 2, Name: "Perl", Visibility: VisibilityPublic, DeletedAt: &deleted}
+
+	cv := CV{
+		Skills: []Skill{goSkill, oldSkill, {ID: 3, Name: "COBOL", Visibility: VisibilityPrivate}},
+		Experiences: []Experience{
+			{ID: 1, CompanyName: "Acme", Visibility: VisibilityPublic, Skills: []Skill{goSkill, oldSkill}},
+			{ID: 2, CompanyName: "Initech", Visibility: VisibilityUnlisted},
+		},
+		Projects: []Project{
+			{ID: 1, Name: "CV", Visibility: VisibilityPublic},
+			{ID: 2, Name: "Draft", Visibility: VisibilityPublic, DeletedAt: &deleted},
+		},
+		Achievements: []Achievement{
+			{Title: "At Acme", Visibility: VisibilityPublic, ExperienceID: id(1)},
+			{Title: "At Initech", Visibility: VisibilityPublic, ExperienceID: id(2)},
+			{Title: "On draft", Visibility: VisibilityPublic, ProjectID: id(2)},
+			{Title: "Secret", Visibility: VisibilityPrivate},
+			{Title: "Standalone", Visibility: VisibilityPublic},
+		},
+	}
+
+	public := cv.Public()
+	assert.Equal(t, []Skill{goSkill}, public.Skills)
+	assert.Len(t, public.Experiences, 1)
+	assert.Equal(t, "Acme", public.Experiences[0].CompanyName)
+	assert.Equal(t, []Skill{goSkill}, public.Experiences[0].Skills)
+	assert.Len(t, public.Projects, 1)
+	assert.Equal(t, "CV", public.Projects[0].Name)
+	var titles []string
+	for _, a := range public.Achievements {
+		titles = append(titles, a.Title)
+	}
+	assert.Equal(t, []string{"At Acme", "Standalone"}, titles)
+	// The original CV is left as it was.
+	assert.Len(t, cv.Experiences[0].Skills, 2)
+}
@@ -22,4 +22,6 @@ var (
 	ErrEndDateBeforeStart = errors.New("end date cannot be before start date")
 	// ErrInvalidProficiency represents an error indicating that proficiency must be between 0 and 100.
 	ErrInvalidProficiency = errors.New("proficiency must be between 0 and 100")
+	// ErrInvalidVisibility represents an error indicating that a visibility is not public, unlisted or private.
+	ErrInvalidVisibility = errors.New("visibility must be public, unlisted or private")
 )
@@ -18,6 +18,8 @@ type Experience struct {
 	Skills      []Skill // Related skills
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Visibility  Visibility
+	DeletedAt   *time.Time // nil unless soft-deleted
 }
 
 // NewExperience creates a validated Experience. Returns error if validation fails.
@@ -32,6 +34,7 @@ func NewExperience(companyName, jobTitle, location string, startDate time.Time,
 		Highlights:  strings.TrimSpace(highlights),
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
+		Visibility:  VisibilityPublic,
 	}
 
 	if err := e.Validate(); err != nil {
@@ -58,9 +61,17 @@ func (e Experience) Validate() error {
 	if e.EndDate != nil && e.EndDate.Before(e.StartDate) {
 		return ErrEndDateBeforeStart
 	}
+	if !e.Visibility.Valid() {
+		return ErrInvalidVisibility
+	}
 	return nil
 }
 
+// IsPublic returns true if the experience is public and not deleted.
+func (e Experience) IsPublic() bool {
+	return e.Visibility == VisibilityPublic && e.DeletedAt == nil
+}
+
 // IsCurrent returns true if this is the current position (no end date).
 func (e Experience) IsCurrent() bool {
 	return e.EndDate == nil
@@ -15,6 +15,8 @@ type Project struct {
 	Skills      []Skill // Related skills
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Visibility  Visibility
+	DeletedAt   *time.Time // nil unless soft-deleted
 }
 
 // NewProject creates a validated Project. Returns error if validation fails.
@@ -26,6 +28,7 @@ func NewProject(name, description string, startDate, endDate *time.Time) (Projec
 		EndDate:     endDate,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
+		Visibility:  VisibilityPublic,
 	}
 
 	if err := p.Validate(); err != nil {
@@ -46,9 +49,17 @@ func (p Project) Validate() error {
 	if p.StartDate != nil && p.EndDate != nil && p.EndDate.Before(*p.StartDate) {
 		return ErrEndDateBeforeStart
 	}
+	if !p.Visibility.Valid() {
+		return ErrInvalidVisibility
+	}
 	return nil
 }
 
+// IsPublic returns true if the project is public and not deleted.
+func (p Project) IsPublic() bool {
+	return p.Visibility == VisibilityPublic && p.DeletedAt == nil
+}
+
 // IsOngoing returns true if the project has no end date.
 func (p Project) IsOngoing() bool {
 	return p.EndDate == nil
@@ -1,6 +1,9 @@
 package domain
 
-import "strings"
+import (
+	"strings"
+	"time"
+)
 
 // Skill represents a specific skill with an ID, name, category, and proficiency level.
 type Skill struct {
@@ -9,6 +12,10 @@ type Skill struct {
 	Category    string
 	Proficiency int32
 	LogoPath    string `json:"logo_url"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Visibility  Visibility
+	DeletedAt   *time.Time // nil unless soft-deleted
 }
 
 // NewSkill creates a validated Skill. Returns error if validation fails.
@@ -18,6 +25,7 @@ func NewSkill(name, category string, proficiency int32, logoPath string) (Skill,
 		Category:    strings.TrimSpace(category),
 		Proficiency: proficiency,
 		LogoPath:    strings.TrimSpace(logoPath),
+		Visibility:  VisibilityPublic,
 	}
 
 	if err := s.Validate(); err != nil {
@@ -38,9 +46,17 @@ func (s Skill) Validate() error {
 	if s.Proficiency < 0 || s.Proficiency > 100 {
 		return ErrInvalidProficiency
 	}
+	if !s.Visibility.Valid() {
+		return ErrInvalidVisibility
+	}
 	return nil
 }
 
+// IsPublic returns true if the skill is public and not deleted.
+func (s Skill) IsPublic() bool {
+	return s.Visibility == VisibilityPublic && s.DeletedAt == nil
+}
+
 // IsExpert returns true if proficiency is 80 or above.
 func (s Skill) IsExpert() bool {
 	return s.Proficiency >= 80
@@ -95,6 +95,7 @@ func TestNewSkill(t *testing.T) {
 				assert.Equal(t, tt.skillName, skill.Name)
 				assert.Equal(t, tt.category, skill.Category)
 				assert.Equal(t, tt.proficiency, skill.Proficiency)
+				assert.Equal(t, VisibilityPublic, skill.Visibility)
 			}
 		})
 	}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// ErrSnapshotNotFound is returned when no snapshot has the requested version or label.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// snapshotLabel restricts labels to what reads well in a URL. A label must contain a
+// non-digit, so it cannot be mistaken for a version.
+var snapshotLabel = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+var digits = regexp.MustCompile(`^[0-9]+$`)
+
+// SnapshotInfo identifies a snapshot of the CV.
+type SnapshotInfo struct {
+	Version int32 `json:"version"`
+	// Label optionally names the snapshot, e.g. after the company it was sent to.
+	Label     string    `json:"label,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Snapshot is the CV as it was when the snapshot was taken.
+type Snapshot struct {
+	SnapshotInfo
+	CV CV `json:"cv"`
+}
+
+// ValidateSnapshotLabel checks that label can name a snapshot. The empty label is valid.
+func ValidateSnapshotLabel(label string) error {
+	if label == "" {
+		return nil
+	}
+	if !snapshotLabel.MatchString(label) || digits.MatchString(label) {
+		return fmt.Errorf("invalid snapshot label %q: use up to 64 letters, digits, '.', '_' or '-', not only digits", label)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSnapshotLabel(t *testing.T) {
+	tests := []struct {
+		label string
+		valid bool
+	}{
+		{"", true},
+		{"acme-2026.10", true},
+		{"v2_final", true},
+		{"42", false},
+		{"acme corp", false},
+		{"acme/2026", false},
+		{strings.Repeat("a", 65), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			err := ValidateSnapshotLabel(tt.label)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
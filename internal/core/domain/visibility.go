@@ -0,0 +1,26 @@
+package domain
+
+// Visibility controls who sees a CV entity.
+type Visibility string
+
+const (
+	// VisibilityPublic entities appear on the public CV. It is the default.
+	VisibilityPublic Visibility = "public"
+	// VisibilityUnlisted entities are kept off the public CV, but are meant to be shared on
+	// request, e.g. in a tailored export.
+	VisibilityUnlisted Visibility = "unlisted"
+	// VisibilityPrivate entities are only ever shown to admins.
+	VisibilityPrivate Visibility = "private"
+)
+
+// Visibilities lists the valid visibilities.
+var Visibilities = []Visibility{VisibilityPublic, VisibilityUnlisted, VisibilityPrivate}
+
+// Valid reports whether v is one of Visibilities.
+func (v Visibility) Valid() bool {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityPrivate:
+		return true
+	}
+	return false
+}
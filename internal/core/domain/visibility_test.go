@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateVisibility(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	validate := map[string]func(Visibility) error{
+		"skill": func(v Visibility) error {
+			return Skill{Name: "Go", Category: "Backend", Visibility: v}.Validate()
+		},
+		"experience": func(v Visibility) error {
+			return Experience{CompanyName: "Acme", JobTitle: "Engineer", StartDate: start, Description: "Work", Visibility: v}.Validate()
+		},
+		"achievement": func(v Visibility) error {
+			return Achievement{Title: "Shipped", Description: "It", Visibility: v}.Validate()
+		},
+		"project": func(v Visibility) error {
+			return Project{Name: "CV", Description: "This", Visibility: v}.Validate()
+		},
+	}
+
+	tests := []struct {
+		visibility Visibility
+		wantErr    error
+	}{
+		{VisibilityPublic, nil},
+		{VisibilityUnlisted, nil},
+		{VisibilityPrivate, nil},
+		{"", ErrInvalidVisibility},
+		{"hidden", ErrInvalidVisibility},
+		{"Public", ErrInvalidVisibility},
+	}
+
+	for entity, fn := range validate {
+		for _, tt := range tests {
+			t.Run(entity+"/"+string(tt.visibility), func(t *testing.T) {
+				assert.ErrorIs(t, fn(tt.visibility), tt.wantErr)
+			})
+		}
+	}
+}
@@ -13,6 +13,8 @@ type AchievementRepository interface {
 	GetAchievementByTitle(ctx context.Context, title string) (domain.Achievement, error)
 	CreateAchievement(ctx context.Context, ach domain.Achievement) (int32, error)
 	UpdateAchievement(ctx context.Context, ach domain.Achievement) error
+	DeleteAchievement(ctx context.Context, id int32) error
 	AddSkillToAchievement(ctx context.Context, achievementID, skillID int32) error
 	ClearSkillsFromAchievement(ctx context.Context, achievementID int32) error
+	GetSkillsForAchievement(ctx context.Context, achievementID int32) ([]domain.Skill, error)
 }
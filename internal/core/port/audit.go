@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// AuditLog specifies the read side of the audit log. Events are written by the database as
+// the audited records change.
+type AuditLog interface {
+	ListAuditEvents(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, error)
+}
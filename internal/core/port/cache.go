@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// Cache specifies a key-value store for encoded values with expiry. Its operations map
+// directly onto Redis GET, SET EX and DEL, so a Redis-compatible server can back it.
+type Cache interface {
+	// Get returns the value stored under key, and false if it is missing or expired.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the keys. Missing keys are ignored.
+	Delete(ctx context.Context, keys ...string) error
+}
@@ -0,0 +1,24 @@
+package port
+
+import (
+	"context"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// CVReader specifies the read side of the CV, as served by the web and export handlers.
+type CVReader interface {
+	GetSkills(ctx context.Context) ([]domain.Skill, error)
+	GetExperiences(ctx context.Context) ([]domain.Experience, error)
+	GetAchievements(ctx context.Context) ([]domain.Achievement, error)
+	GetProjects(ctx context.Context) ([]domain.Project, error)
+	GetCV(ctx context.Context) (domain.CV, error)
+}
+
+// CVStore specifies the reads the CV is assembled from, each with the linked skills.
+type CVStore interface {
+	GetSkills(ctx context.Context) ([]domain.Skill, error)
+	GetAllExperiencesWithSkills(ctx context.Context) ([]domain.Experience, error)
+	GetAllAchievementsWithSkills(ctx context.Context) ([]domain.Achievement, error)
+	GetAllProjectsWithSkills(ctx context.Context) ([]domain.Project, error)
+}
@@ -14,6 +14,8 @@ type ExperienceRepository interface {
 	GetExperienceByCompanyAndTitle(ctx context.Context, companyName, jobTitle string) (domain.Experience, error)
 	CreateExperience(ctx context.Context, exp domain.Experience) (int32, error)
 	UpdateExperience(ctx context.Context, exp domain.Experience) error
+	DeleteExperience(ctx context.Context, id int32) error
 	AddSkillToExperience(ctx context.Context, experienceID, skillID int32) error
 	ClearSkillsFromExperience(ctx context.Context, experienceID int32) error
+	GetSkillsForExperience(ctx context.Context, experienceID int32) ([]domain.Skill, error)
 }
@@ -13,6 +13,8 @@ type ProjectRepository interface {
 	GetProjectByName(ctx context.Context, name string) (domain.Project, error)
 	CreateProject(ctx context.Context, proj domain.Project) (int32, error)
 	UpdateProject(ctx context.Context, proj domain.Project) error
+	DeleteProject(ctx context.Context, id int32) error
 	AddSkillToProject(ctx context.Context, projectID, skillID int32) error
 	ClearSkillsFromProject(ctx context.Context, projectID int32) error
+	GetSkillsForProject(ctx context.Context, projectID int32) ([]domain.Skill, error)
 }
@@ -0,0 +1,12 @@
+package port
+
+import "context"
+
+// Replication tells how far a read replica has replayed the writes of its primary. Positions
+// are opaque and only compared by the database.
+type Replication interface {
+	// PrimaryPosition returns the position of the latest write committed on the primary.
+	PrimaryPosition(ctx context.Context) (string, error)
+	// ReplayedTo reports whether the replica has replayed the primary's writes up to pos.
+	ReplayedTo(ctx context.Context, pos string) (bool, error)
+}
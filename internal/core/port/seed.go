@@ -0,0 +1,10 @@
+package port
+
+import "context"
+
+// SeedSource specifies how raw seed files, such as skills.json, are read.
+type SeedSource interface {
+	// ReadFile returns the contents of the named seed file.
+	// It returns an error wrapping fs.ErrNotExist if the file does not exist.
+	ReadFile(ctx context.Context, name string) ([]byte, error)
+}
@@ -12,4 +12,5 @@ type SkillRepository interface {
 	GetSkillByName(ctx context.Context, name string) (domain.Skill, error)
 	CreateSkill(ctx context.Context, skill domain.Skill) error
 	UpdateSkill(ctx context.Context, skill domain.Skill) error
+	DeleteSkill(ctx context.Context, id int32) error
 }
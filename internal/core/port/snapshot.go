@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// SnapshotStore specifies how CV snapshots are kept. A snapshot is stored as an encoded
+// document, and never changes once created.
+type SnapshotStore interface {
+	CreateSnapshot(ctx context.Context, label string, document []byte) (domain.SnapshotInfo, error)
+	ListSnapshots(ctx context.Context) ([]domain.SnapshotInfo, error)
+	GetSnapshot(ctx context.Context, version int32) (domain.SnapshotInfo, []byte, error)
+	GetSnapshotByLabel(ctx context.Context, label string) (domain.SnapshotInfo, []byte, error)
+}
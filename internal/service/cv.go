@@ -2,40 +2,105 @@ package service
 
 import (
 	"context"
-	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/postgres"
+	"fmt"
 	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/guillermoBallester/go-platform-cv/internal/service")
+
 // CVService represents a service that provides methods for handling CvService operations.
 type CVService struct {
-	dbRepositories postgres.Repositories
+	store port.CVStore
 }
 
-// NewCVService creates a new CVService instance with the provided repositories.
+// NewCVService creates a new CVService instance reading from the provided store, such as the
+// Postgres repositories or an in-memory store.
 func NewCVService(
-	dbRepositories postgres.Repositories,
+	store port.CVStore,
 ) *CVService {
 	return &CVService{
-		dbRepositories: dbRepositories,
+		store: store,
 	}
 }
 
 // GetSkills retrieves a list of skills from the repository using the provided context.
 func (s *CVService) GetSkills(ctx context.Context) ([]domain.Skill, error) {
-	return s.dbRepositories.Skills.GetSkills(ctx)
+	ctx, span := tracer.Start(ctx, "CVService.GetSkills")
+	defer span.End()
+
+	result, err := s.store.GetSkills(ctx)
+	return result, spanError(span, err)
 }
 
 // GetExperiences retrieves all experiences with their associated skills.
 func (s *CVService) GetExperiences(ctx context.Context) ([]domain.Experience, error) {
-	return s.dbRepositories.Experiences.GetAllExperiencesWithSkills(ctx)
+	ctx, span := tracer.Start(ctx, "CVService.GetExperiences")
+	defer span.End()
+
+	result, err := s.store.GetAllExperiencesWithSkills(ctx)
+	return result, spanError(span, err)
 }
 
 // GetAchievements retrieves all achievements with their associated skills.
 func (s *CVService) GetAchievements(ctx context.Context) ([]domain.Achievement, error) {
-	return s.dbRepositories.Achievements.GetAllAchievementsWithSkills(ctx)
+	ctx, span := tracer.Start(ctx, "CVService.GetAchievements")
+	defer span.End()
+
+	result, err := s.store.GetAllAchievementsWithSkills(ctx)
+	return result, spanError(span, err)
 }
 
 // GetProjects retrieves all projects with their associated skills.
 func (s *CVService) GetProjects(ctx context.Context) ([]domain.Project, error) {
-	return s.dbRepositories.Projects.GetAllProjectsWithSkills(ctx)
+	ctx, span := tracer.Start(ctx, "CVService.GetProjects")
+	defer span.End()
+
+	result, err := s.store.GetAllProjectsWithSkills(ctx)
+	return result, spanError(span, err)
+}
+
+// GetCV retrieves the whole CV: skills, experiences, achievements and projects.
+func (s *CVService) GetCV(ctx context.Context) (cv domain.CV, err error) {
+	ctx, span := tracer.Start(ctx, "CVService.GetCV")
+	defer func() {
+		_ = spanError(span, err)
+		span.End()
+	}()
+
+	skills, err := s.GetSkills(ctx)
+	if err != nil {
+		return domain.CV{}, fmt.Errorf("skills: %w", err)
+	}
+	experiences, err := s.GetExperiences(ctx)
+	if err != nil {
+		return domain.CV{}, fmt.Errorf("experiences: %w", err)
+	}
+	achievements, err := s.GetAchievements(ctx)
+	if err != nil {
+		return domain.CV{}, fmt.Errorf("achievements: %w", err)
+	}
+	projects, err := s.GetProjects(ctx)
+	if err != nil {
+		return domain.CV{}, fmt.Errorf("projects: %w", err)
+	}
+
+	return domain.CV{
+		Skills:       skills,
+		Experiences:  experiences,
+		Achievements: achievements,
+		Projects:     projects,
+	}, nil
+}
+
+// spanError marks the span as failed if err is not nil, and returns err.
+func spanError(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
 }
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+)
+
+// Cache keys of the CV reads.
+const (
+	cacheKeySkills       = "cv:skills"
+	cacheKeyExperiences  = "cv:experiences"
+	cacheKeyAchievements = "cv:achievements"
+	cacheKeyProjects     = "cv:projects"
+	cacheKeyCV           = "cv:all"
+)
+
+var cacheKeys = []string{cacheKeySkills, cacheKeyExperiences, cacheKeyAchievements, cacheKeyProjects, cacheKeyCV}
+
+// CacheObserver is notified of every cache lookup and invalidation.
+type CacheObserver interface {
+	ObserveCacheLookup(key string, hit bool)
+	ObserveCacheInvalidation()
+}
+
+// CacheStats counts the lookups and invalidations of a CachedCVReader since it was created.
+type CacheStats struct {
+	Hits          uint64  `json:"hits"`
+	Misses        uint64  `json:"misses"`
+	HitRatio      float64 `json:"hit_ratio"`
+	Invalidations uint64  `json:"invalidations"`
+}
+
+// CachedCVReader is a read-through cache in front of a CVReader. Values are stored JSON encoded
+// for ttl, so the cache may be shared between instances. A failing cache is logged and
+// bypassed: reads then go to the wrapped reader.
+type CachedCVReader struct {
+	next     port.CVReader
+	cache    port.Cache
+	ttl      time.Duration
+	observer CacheObserver
+
+	// generation is bumped on every invalidation, so a read that started before it does not
+	// store what it loaded.
+	generation    atomic.Uint64
+	hits          atomic.Uint64
+	misses        atomic.Uint64
+	invalidations atomic.Uint64
+}
+
+var _ port.CVReader = (*CachedCVReader)(nil)
+
+// NewCachedCVReader creates a CachedCVReader caching the reads of next in cache for ttl.
+func NewCachedCVReader(next port.CVReader, cache port.Cache, ttl time.Duration, observer CacheObserver) *CachedCVReader {
+	return &CachedCVReader{
+		next:     next,
+		cache:    cache,
+		ttl:      ttl,
+		observer: observer,
+	}
+}
+
+// GetSkills returns the cached skills, loading them on a miss.
+func (c *CachedCVReader) GetSkills(ctx context.Context) ([]domain.Skill, error) {
+	return readThrough(ctx, c, cacheKeySkills, c.next.GetSkills)
+}
+
+// GetExperiences returns the cached experiences, loading them on a miss.
+func (c *CachedCVReader) GetExperiences(ctx context.Context) ([]domain.Experience, error) {
+	return readThrough(ctx, c, cacheKeyExperiences, c.next.GetExperiences)
+}
+
+// GetAchievements returns the cached achievements, loading them on a miss.
+func (c *CachedCVReader) GetAchievements(ctx context.Context) ([]domain.Achievement, error) {
+	return readThrough(ctx, c, cacheKeyAchievements, c.next.GetAchievements)
+}
+
+// GetProjects returns the cached projects, loading them on a miss.
+func (c *CachedCVReader) GetProjects(ctx context.Context) ([]domain.Project, error) {
+	return readThrough(ctx, c, cacheKeyProjects, c.next.GetProjects)
+}
+
+// GetCV returns the cached CV, loading it on a miss.
+func (c *CachedCVReader) GetCV(ctx context.Context) (domain.CV, error) {
+	return readThrough(ctx, c, cacheKeyCV, c.next.GetCV)
+}
+
+// Invalidate drops every cached read. It is called whenever CV content changes.
+func (c *CachedCVReader) Invalidate(ctx context.Context) error {
+	c.generation.Add(1)
+	c.invalidations.Add(1)
+	c.observer.ObserveCacheInvalidation()
+	if err := c.cache.Delete(ctx, cacheKeys...); err != nil {
+		return fmt.Errorf("invalidating cache: %w", err)
+	}
+	return nil
+}
+
+// Stats returns the lookup and invalidation counts.
+func (c *CachedCVReader) Stats() CacheStats {
+	stats := CacheStats{
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Invalidations: c.invalidations.Load(),
+	}
+	if total := stats.Hits + stats.Misses; total > 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(total)
+	}
+	return stats
+}
+
+func readThrough[T any](ctx context.Context, c *CachedCVReader, key string, load func(context.Context) (T, error)) (T, error) {
+	data, ok, err := c.cache.Get(ctx, key)
+	if err != nil {
+		slog.WarnContext(ctx, "cache read failed", "key", key, "error", err)
+	}
+	if ok {
+		var value T
+		if err := json.Unmarshal(data, &value); err == nil {
+			c.hits.Add(1)
+			c.observer.ObserveCacheLookup(key, true)
+			return value, nil
+		}
+		slog.WarnContext(ctx, "cache entry undecodable", "key", key, "error", err)
+	}
+	c.misses.Add(1)
+	c.observer.ObserveCacheLookup(key, false)
+
+	generation := c.generation.Load()
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+	if c.generation.Load() != generation {
+		return value, nil
+	}
+	if data, err = json.Marshal(value); err == nil {
+		err = c.cache.Set(ctx, key, data, c.ttl)
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "cache write failed", "key", key, "error", err)
+	}
+	return value, nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/cache"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingReader is a CVReader counting its loads. onLoad, if set, runs during each load.
+type countingReader struct {
+	skills []domain.Skill
+	err    error
+	loads  int
+	onLoad func()
+}
+
+func (r *countingReader) GetSkills(context.Context) ([]domain.Skill, error) {
+	r.loads++
+	if r.onLoad != nil {
+		r.onLoad()
+	}
+	return r.skills, r.err
+}
+
+func (r *countingReader) GetExperiences(context.Context) ([]domain.Experience, error) {
+	return nil, nil
+}
+
+func (r *countingReader) GetAchievements(context.Context) ([]domain.Achievement, error) {
+	return nil, nil
+}
+
+func (r *countingReader) GetProjects(context.Context) ([]domain.Project, error) {
+	return nil, nil
+}
+
+func (r *countingReader) GetCV(context.Context) (domain.CV, error) {
+	return domain.CV{}, nil
+}
+
+type nopCacheObserver struct{}
+
+func (nopCacheObserver) ObserveCacheLookup(string, bool) {}
+func (nopCacheObserver) ObserveCacheInvalidation()       {}
+
+// failingCache is a cache whose every operation fails.
+type failingCache struct{}
+
+func (failingCache) Get(context.Context, string) ([]byte, bool, error) {
+	return nil, false, errors.New("cache down")
+}
+
+func (failingCache) Set(context.Context, string, []byte, time.Duration) error {
+	return errors.New("cache down")
+}
+
+func (failingCache) Delete(context.Context, ...string) error {
+	return errors.New("cache down")
+}
+
+func TestCachedCVReader(t *testing.T) {
+	ctx := context.Background()
+	next := &countingReader{skills: []domain.Skill{{ID: 1, Name: "Go", LogoPath: "/go.svg"}}}
+	c := NewCachedCVReader(next, cache.NewMemory(), time.Minute, nopCacheObserver{})
+
+	for range 3 {
+		skills, err := c.GetSkills(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, next.skills, skills)
+	}
+	assert.Equal(t, 1, next.loads)
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 1, HitRatio: 2.0 / 3}, c.Stats())
+
+	require.NoError(t, c.Invalidate(ctx))
+	_, err := c.GetSkills(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.loads)
+	assert.Equal(t, uint64(1), c.Stats().Invalidations)
+}
+
+func TestCachedCVReaderDoesNotCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	next := &countingReader{err: errors.New("db down")}
+	c := NewCachedCVReader(next, cache.NewMemory(), time.Minute, nopCacheObserver{})
+
+	_, err := c.GetSkills(ctx)
+	require.Error(t, err)
+	_, err = c.GetSkills(ctx)
+	require.Error(t, err)
+	assert.Equal(t, 2, next.loads)
+}
+
+func TestCachedCVReaderInvalidatedDuringLoad(t *testing.T) {
+	ctx := context.Background()
+	next := &countingReader{skills: []domain.Skill{{Name: "Go"}}}
+	c := NewCachedCVReader(next, cache.NewMemory(), time.Minute, nopCacheObserver{})
+
+	// Content changes while the first read is loading, so what it loaded is stale.
+	next.onLoad = func() {
+		next.onLoad = nil
+		require.NoError(t, c.Invalidate(ctx))
+	}
+	_, err := c.GetSkills(ctx)
+	require.NoError(t, err)
+	_, err = c.GetSkills(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.loads)
+}
+
+func TestCachedCVReaderBypassesFailingCache(t *testing.T) {
+	ctx := context.Background()
+	next := &countingReader{skills: []domain.Skill{{Name: "Go"}}}
+	c := NewCachedCVReader(next, failingCache{}, time.Minute, nopCacheObserver{})
+
+	skills, err := c.GetSkills(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, next.skills, skills)
+	assert.Error(t, c.Invalidate(ctx))
+}
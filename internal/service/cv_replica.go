@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+)
+
+// replicaRetryAfter is how long reads stay on the primary after the replica failed.
+const replicaRetryAfter = 30 * time.Second
+
+// ReplicaCVReader reads the CV from a read replica, and from the primary while the replica
+// fails or has not yet replayed the latest change.
+type ReplicaCVReader struct {
+	primary     port.CVReader
+	replica     port.CVReader
+	replication port.Replication
+
+	mu sync.Mutex
+	// pending is the primary position the replica must reach before it is read again; it is
+	// empty when the replica has caught up.
+	pending string
+	// downUntil is when the replica is tried again after failing.
+	downUntil time.Time
+}
+
+var _ port.CVReader = (*ReplicaCVReader)(nil)
+
+// NewReplicaCVReader creates a ReplicaCVReader reading replica, or primary when it lags.
+func NewReplicaCVReader(primary, replica port.CVReader, replication port.Replication) *ReplicaCVReader {
+	return &ReplicaCVReader{
+		primary:     primary,
+		replica:     replica,
+		replication: replication,
+	}
+}
+
+// Pin sends reads to the primary until the replica has replayed every write committed so
+// far. It is called whenever CV content changes, before the cache is flushed, so the cache is
+// not refilled from a replica that has not seen the change yet.
+func (r *ReplicaCVReader) Pin(ctx context.Context) error {
+	pos, err := r.replication.PrimaryPosition(ctx)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.pending = pos
+	r.mu.Unlock()
+	return nil
+}
+
+// GetSkills returns the skills.
+func (r *ReplicaCVReader) GetSkills(ctx context.Context) ([]domain.Skill, error) {
+	return readReplica(ctx, r, port.CVReader.GetSkills)
+}
+
+// GetExperiences returns the experiences.
+func (r *ReplicaCVReader) GetExperiences(ctx context.Context) ([]domain.Experience, error) {
+	return readReplica(ctx, r, port.CVReader.GetExperiences)
+}
+
+// GetAchievements returns the achievements.
+func (r *ReplicaCVReader) GetAchievements(ctx context.Context) ([]domain.Achievement, error) {
+	return readReplica(ctx, r, port.CVReader.GetAchievements)
+}
+
+// GetProjects returns the projects.
+func (r *ReplicaCVReader) GetProjects(ctx context.Context) ([]domain.Project, error) {
+	return readReplica(ctx, r, port.CVReader.GetProjects)
+}
+
+// GetCV returns the CV.
+func (r *ReplicaCVReader) GetCV(ctx context.Context) (domain.CV, error) {
+	return readReplica(ctx, r, port.CVReader.GetCV)
+}
+
+// useReplica reports whether the replica is up and has replayed the pinned position.
+func (r *ReplicaCVReader) useReplica(ctx context.Context) bool {
+	r.mu.Lock()
+	pos, down := r.pending, time.Now().Before(r.downUntil)
+	r.mu.Unlock()
+	if down {
+		return false
+	}
+	if pos == "" {
+		return true
+	}
+
+	replayed, err := r.replication.ReplayedTo(ctx, pos)
+	if err != nil {
+		r.replicaFailed(ctx, err)
+		return false
+	}
+	if !replayed {
+		return false
+	}
+	r.mu.Lock()
+	// A newer pin taken meanwhile still holds.
+	if r.pending == pos {
+		r.pending = ""
+	}
+	r.mu.Unlock()
+	return true
+}
+
+// replicaFailed sends reads to the primary for replicaRetryAfter.
+func (r *ReplicaCVReader) replicaFailed(ctx context.Context, err error) {
+	slog.WarnContext(ctx, "replica unavailable; reading from the primary", "retry_in", replicaRetryAfter.String(), "error", err)
+	r.mu.Lock()
+	r.downUntil = time.Now().Add(replicaRetryAfter)
+	r.mu.Unlock()
+}
+
+func readReplica[T any](ctx context.Context, r *ReplicaCVReader, read func(port.CVReader, context.Context) (T, error)) (T, error) {
+	if !r.useReplica(ctx) {
+		return read(r.primary, ctx)
+	}
+	value, err := read(r.replica, ctx)
+	if err == nil || ctx.Err() != nil {
+		return value, err
+	}
+	r.replicaFailed(ctx, err)
+	return read(r.primary, ctx)
+}
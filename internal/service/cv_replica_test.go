@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReplication is at position primary, and the replica has replayed up to replayed.
+// Positions are plain integers here.
+type fakeReplication struct {
+	primary  int
+	replayed int
+	err      error
+}
+
+func (f *fakeReplication) PrimaryPosition(context.Context) (string, error) {
+	return strconv.Itoa(f.primary), nil
+}
+
+func (f *fakeReplication) ReplayedTo(_ context.Context, pos string) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	n, err := strconv.Atoi(pos)
+	return f.replayed >= n, err
+}
+
+func TestReplicaCVReader(t *testing.T) {
+	unreachable := errors.New("replica down")
+
+	tests := []struct {
+		name        string
+		pin         bool
+		replayed    int
+		lagErr      error
+		replicaErr  error
+		fromReplica bool
+	}{
+		{"no change", false, 0, nil, nil, true},
+		{"replica behind the change", true, 0, nil, nil, false},
+		{"replica caught up", true, 1, nil, nil, true},
+		{"replica position unreadable", true, 0, unreachable, nil, false},
+		{"replica read fails", false, 0, nil, unreachable, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := &countingReader{skills: []domain.Skill{{Name: "primary"}}}
+			replica := &countingReader{skills: []domain.Skill{{Name: "replica"}}, err: tt.replicaErr}
+			replication := &fakeReplication{primary: 1, replayed: tt.replayed, err: tt.lagErr}
+			r := NewReplicaCVReader(primary, replica, replication)
+			if tt.pin {
+				require.NoError(t, r.Pin(context.Background()))
+			}
+
+			skills, err := r.GetSkills(context.Background())
+			require.NoError(t, err)
+			expected := "primary"
+			if tt.fromReplica {
+				expected = "replica"
+			}
+			assert.Equal(t, expected, skills[0].Name)
+		})
+	}
+}
+
+func TestReplicaCVReaderRetriesAfterFailure(t *testing.T) {
+	primary := &countingReader{}
+	replica := &countingReader{err: errors.New("replica down")}
+	r := NewReplicaCVReader(primary, replica, &fakeReplication{})
+
+	for range 3 {
+		_, err := r.GetSkills(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, replica.loads, "a failed replica is not tried again right away")
+	assert.Equal(t, 3, primary.loads)
+}
+
+func TestReplicaCVReaderKeepsNewerPin(t *testing.T) {
+	replication := &fakeReplication{primary: 1, replayed: 1}
+	r := NewReplicaCVReader(&countingReader{}, &countingReader{}, replication)
+	require.NoError(t, r.Pin(context.Background()))
+
+	// The replica catches up with the first change, and reads return to it.
+	_, err := r.GetSkills(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, r.pending)
+
+	replication.primary = 2
+	require.NoError(t, r.Pin(context.Background()))
+	_, err = r.GetSkills(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, r.pending, "the replica has not replayed the second change")
+}
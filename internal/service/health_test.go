@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthServiceReady(t *testing.T) {
+	ok := HealthCheck{Name: "database", Check: func(context.Context) error { return nil }}
+	failing := HealthCheck{Name: "migrations", Check: func(context.Context) error { return errors.New("2 migrations pending") }}
+	slow := HealthCheck{Name: "database", Check: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}}
+
+	tests := []struct {
+		name         string
+		checks       []HealthCheck
+		seeded       bool
+		shuttingDown bool
+		expected     string
+		failed       []string
+	}{
+		{"ready", []HealthCheck{ok}, true, false, HealthOK, nil},
+		{"not seeded", []HealthCheck{ok}, false, false, HealthFail, []string{"seed"}},
+		{"failing dependency", []HealthCheck{ok, failing}, true, false, HealthFail, []string{"migrations"}},
+		{"timed out dependency", []HealthCheck{slow}, true, false, HealthFail, []string{"database"}},
+		{"shutting down", []HealthCheck{ok}, true, true, HealthFail, []string{"shutdown"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewHealthService(10*time.Millisecond, tt.checks...)
+			if tt.seeded {
+				s.MarkSeeded()
+			}
+			if tt.shuttingDown {
+				s.MarkShuttingDown()
+			}
+
+			report := s.Ready(context.Background())
+			assert.Equal(t, tt.expected, report.Status)
+
+			var failed []string
+			for _, name := range []string{"shutdown", "seed", "database", "migrations"} {
+				if result, ok := report.Checks[name]; ok && result.Status == HealthFail {
+					assert.NotEmpty(t, result.Error)
+					failed = append(failed, name)
+				}
+			}
+			assert.Equal(t, tt.failed, failed)
+		})
+	}
+}
+
+func TestHealthServiceLiveIgnoresShutdown(t *testing.T) {
+	s := NewHealthService(time.Second)
+	s.MarkShuttingDown()
+	assert.True(t, s.Live(context.Background()).OK())
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// sameSkill reports whether a stored skill already matches its seed. A deleted record never
+// does, so seeding it again restores it; the same goes for the other entities.
+func sameSkill(stored, seed domain.Skill) bool {
+	return stored.DeletedAt == nil &&
+		stored.Visibility == seed.Visibility &&
+		stored.Category == seed.Category &&
+		stored.Proficiency == seed.Proficiency &&
+		stored.LogoPath == seed.LogoPath
+}
+
+// sameExperience reports whether a stored experience already matches its seed.
+func sameExperience(stored, seed domain.Experience) bool {
+	return stored.DeletedAt == nil &&
+		stored.Visibility == seed.Visibility &&
+		stored.CompanyName == seed.CompanyName &&
+		stored.JobTitle == seed.JobTitle &&
+		stored.Location == seed.Location &&
+		stored.StartDate.Equal(seed.StartDate) &&
+		sameDate(stored.EndDate, seed.EndDate) &&
+		stored.Description == seed.Description &&
+		stored.Highlights == seed.Highlights
+}
+
+// sameAchievement reports whether a stored achievement already matches its seed.
+func sameAchievement(stored, seed domain.Achievement) bool {
+	return stored.DeletedAt == nil &&
+		stored.Visibility == seed.Visibility &&
+		stored.Title == seed.Title &&
+		stored.Description == seed.Description &&
+		sameDate(stored.Date, seed.Date) &&
+		sameID(stored.ExperienceID, seed.ExperienceID) &&
+		sameID(stored.ProjectID, seed.ProjectID)
+}
+
+// sameProject reports whether a stored project already matches its seed.
+func sameProject(stored, seed domain.Project) bool {
+	return stored.DeletedAt == nil &&
+		stored.Visibility == seed.Visibility &&
+		stored.Name == seed.Name &&
+		stored.Description == seed.Description &&
+		sameDate(stored.StartDate, seed.StartDate) &&
+		sameDate(stored.EndDate, seed.EndDate)
+}
+
+// sameSkills reports whether the linked skills are exactly the resolved seed skill IDs.
+func sameSkills(linked []domain.Skill, skillIDs []int32) bool {
+	want := make(map[int32]struct{}, len(skillIDs))
+	for _, id := range skillIDs {
+		want[id] = struct{}{}
+	}
+	if len(linked) != len(want) {
+		return false
+	}
+	for _, skill := range linked {
+		if _, ok := want[skill.ID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func sameID(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
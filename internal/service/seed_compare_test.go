@@ -0,0 +1,78 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameSkills(t *testing.T) {
+	linked := []domain.Skill{{ID: 1, Name: "Go"}, {ID: 2, Name: "Postgres"}}
+
+	tests := []struct {
+		name     string
+		linked   []domain.Skill
+		skillIDs []int32
+		expected bool
+	}{
+		{"same set", linked, []int32{1, 2}, true},
+		{"same set different order", linked, []int32{2, 1}, true},
+		{"missing skill", linked, []int32{1}, false},
+		{"extra skill", linked, []int32{1, 2, 3}, false},
+		{"different skill", linked, []int32{1, 3}, false},
+		{"both empty", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, sameSkills(tt.linked, tt.skillIDs))
+		})
+	}
+}
+
+func TestSameExperience(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	otherEnd := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	stored := domain.Experience{
+		ID:          7,
+		CompanyName: "Acme",
+		JobTitle:    "Engineer",
+		StartDate:   start,
+		EndDate:     &end,
+		Description: "Work",
+		Visibility:  domain.VisibilityPublic,
+		CreatedAt:   time.Now().Add(-time.Hour),
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(e *domain.Experience)
+		expected bool
+	}{
+		{"identical content", func(e *domain.Experience) {}, true},
+		{"different description", func(e *domain.Experience) { e.Description = "Other" }, false},
+		{"end date removed", func(e *domain.Experience) { e.EndDate = nil }, false},
+		{"end date changed", func(e *domain.Experience) { e.EndDate = &otherEnd }, false},
+		{"visibility changed", func(e *domain.Experience) { e.Visibility = domain.VisibilityUnlisted }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed := stored
+			seed.ID = 0
+			seed.CreatedAt = time.Now()
+			tt.mutate(&seed)
+			assert.Equal(t, tt.expected, sameExperience(stored, seed))
+		})
+	}
+
+	t.Run("deleted record", func(t *testing.T) {
+		deleted := stored
+		deleted.DeletedAt = &end
+		assert.False(t, sameExperience(deleted, stored))
+	})
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+)
+
+// LoadSeedCV builds the CV from the seed files of source without a database, as a freshly
+// seeded database would hold it: records get sequential IDs in file order, which achievement
+// experience_id and project_id refer to, and skills are linked by name. Invalid records and
+// unknown skills are reported and left out; the error is only set if a file could not be read.
+func LoadSeedCV(ctx context.Context, source port.SeedSource) (domain.CV, *SeedReport, error) {
+	report := &SeedReport{}
+	records, err := decodeSeedFiles(ctx, source, report)
+	if err != nil {
+		return domain.CV{}, report, err
+	}
+
+	var cv domain.CV
+	skills := make(map[string]domain.Skill)
+	linked := func(entity, key string, names []string) []domain.Skill {
+		var result []domain.Skill
+		for _, name := range names {
+			skill, ok := skills[name]
+			if !ok {
+				report.unknownSkill(entity, key, name)
+				continue
+			}
+			result = append(result, skill)
+		}
+		return result
+	}
+
+	var skillSeeds []skillSeed
+	decodeSeeds(records, "skills", &skillSeeds, report, &report.Skills)
+	for _, seed := range skillSeeds {
+		skill, err := parseSkillSeed(seed)
+		if err != nil {
+			report.fail(&report.Skills, "skill", strings.TrimSpace(seed.Name), err)
+			continue
+		}
+		skill.ID = int32(len(cv.Skills) + 1)
+		skills[skill.Name] = skill
+		cv.Skills = append(cv.Skills, skill)
+		report.Skills.Created++
+	}
+
+	var experienceSeeds []experienceSeed
+	decodeSeeds(records, "experiences", &experienceSeeds, report, &report.Experiences)
+	for _, seed := range experienceSeeds {
+		key := experienceKey(seed.CompanyName, seed.JobTitle)
+		exp, err := parseExperienceSeed(seed)
+		if err != nil {
+			report.fail(&report.Experiences, "experience", key, err)
+			continue
+		}
+		exp.ID = int32(len(cv.Experiences) + 1)
+		exp.Skills = linked("experience", key, seed.Skills)
+		cv.Experiences = append(cv.Experiences, exp)
+		report.Experiences.Created++
+	}
+
+	var achievementSeeds []achievementSeed
+	decodeSeeds(records, "achievements", &achievementSeeds, report, &report.Achievements)
+	for _, seed := range achievementSeeds {
+		key := strings.TrimSpace(seed.Title)
+		ach, err := parseAchievementSeed(seed)
+		if err != nil {
+			report.fail(&report.Achievements, "achievement", key, err)
+			continue
+		}
+		ach.ID = int32(len(cv.Achievements) + 1)
+		ach.Skills = linked("achievement", key, seed.Skills)
+		cv.Achievements = append(cv.Achievements, ach)
+		report.Achievements.Created++
+	}
+
+	var projectSeeds []projectSeed
+	decodeSeeds(records, "projects", &projectSeeds, report, &report.Projects)
+	for _, seed := range projectSeeds {
+		key := strings.TrimSpace(seed.Name)
+		proj, err := parseProjectSeed(seed)
+		if err != nil {
+			report.fail(&report.Projects, "project", key, err)
+			continue
+		}
+		proj.ID = int32(len(cv.Projects) + 1)
+		proj.Skills = linked("project", key, seed.Skills)
+		cv.Projects = append(cv.Projects, proj)
+		report.Projects.Created++
+	}
+
+	return cv, report, nil
+}
+
+// decodeSeeds unmarshals the decoded records of an entity into seeds, reporting a failure if
+// they do not fit. Entities whose file was missing or invalid are left empty.
+func decodeSeeds(records map[string][]byte, entity string, seeds any, report *SeedReport, counts *SeedCounts) {
+	data, ok := records[entity]
+	if !ok {
+		return
+	}
+	if err := json.Unmarshal(data, seeds); err != nil {
+		report.fail(counts, entity, "", err)
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSeedCV(t *testing.T) {
+	source := mapSource{
+		"skills.json":       `[{"name": "Go", "category": "Language", "proficiency": 90, "logo_url": ""}]`,
+		"experiences.yaml":  "- company_name: Acme\n  job_title: Engineer\n  location: Oslo\n  start_date: 2020-01-01\n  end_date: null\n  description: Built things\n  highlights: ''\n  skills: [Go, Rust]\n",
+		"achievements.json": `[{"title": "Shipped", "description": "It works", "date": null, "experience_id": 1, "project_id": null, "skills": ["Go"]}]`,
+		"projects.json":     `[{"name": "CV", "description": "This site", "start_date": null, "end_date": null, "skills": ["Go"]}]`,
+	}
+
+	cv, report, err := LoadSeedCV(context.Background(), source)
+	require.NoError(t, err)
+
+	require.Len(t, cv.Skills, 1)
+	assert.Equal(t, int32(1), cv.Skills[0].ID)
+	require.Len(t, cv.Experiences, 1)
+	assert.Equal(t, "Go", cv.Experiences[0].Skills[0].Name)
+	assert.Len(t, cv.Experiences[0].Skills, 1)
+	require.Len(t, cv.Achievements, 1)
+	assert.Equal(t, cv.Experiences[0].ID, *cv.Achievements[0].ExperienceID)
+	require.Len(t, cv.Projects, 1)
+
+	assert.Equal(t, 1, report.Skills.Created)
+	require.Len(t, report.UnknownSkills, 1)
+	assert.Equal(t, "Rust", report.UnknownSkills[0].Skill)
+}
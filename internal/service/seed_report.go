@@ -0,0 +1,92 @@
+package service
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/seedformat"
+)
+
+// ErrSeedProblems is returned by a strict seed run when any record failed or referenced an unknown skill.
+var ErrSeedProblems = errors.New("seed run reported problems")
+
+// SeedCounts holds the per-entity outcome of a seed run.
+type SeedCounts struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	Deleted   int `json:"deleted"`
+	Failed    int `json:"failed"`
+}
+
+// LogValue logs the counts as a group, e.g. skills.created=3.
+func (c SeedCounts) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("created", c.Created),
+		slog.Int("updated", c.Updated),
+		slog.Int("unchanged", c.Unchanged),
+		slog.Int("deleted", c.Deleted),
+		slog.Int("failed", c.Failed),
+	)
+}
+
+// SeedFailure describes a seed record that could not be applied.
+type SeedFailure struct {
+	Entity string `json:"entity"`
+	Key    string `json:"key"`
+	Reason string `json:"reason"`
+}
+
+// UnknownSkillRef describes a seed record linking a skill name that does not exist.
+type UnknownSkillRef struct {
+	Entity string `json:"entity"`
+	Key    string `json:"key"`
+	Skill  string `json:"skill"`
+}
+
+// PrunedRecord describes a database record deleted because it no longer appears in the seed files.
+type PrunedRecord struct {
+	Entity string `json:"entity"`
+	Key    string `json:"key"`
+}
+
+// SeedReport summarises the outcome of a seed run.
+type SeedReport struct {
+	DryRun        bool              `json:"dry_run"`
+	Skills        SeedCounts        `json:"skills"`
+	Experiences   SeedCounts        `json:"experiences"`
+	Achievements  SeedCounts        `json:"achievements"`
+	Projects      SeedCounts        `json:"projects"`
+	Pruned        []PrunedRecord    `json:"pruned,omitempty"`
+	Failures      []SeedFailure     `json:"failures,omitempty"`
+	UnknownSkills []UnknownSkillRef `json:"unknown_skills,omitempty"`
+}
+
+// HasProblems returns true if any record failed or referenced an unknown skill.
+func (r *SeedReport) HasProblems() bool {
+	return len(r.Failures) > 0 || len(r.UnknownSkills) > 0
+}
+
+func (r *SeedReport) fail(counts *SeedCounts, entity, key string, err error) {
+	counts.Failed++
+	r.Failures = append(r.Failures, SeedFailure{Entity: entity, Key: key, Reason: err.Error()})
+}
+
+// failIssue records a problem found while validating a seed file, keyed by its file position.
+func (r *SeedReport) failIssue(counts *SeedCounts, entity string, issue seedformat.Issue) {
+	reason := issue.Message
+	if issue.Path != "" {
+		reason = issue.Path + ": " + reason
+	}
+	counts.Failed++
+	r.Failures = append(r.Failures, SeedFailure{Entity: entity, Key: issue.Location(), Reason: reason})
+}
+
+func (r *SeedReport) unknownSkill(entity, key, skill string) {
+	r.UnknownSkills = append(r.UnknownSkills, UnknownSkillRef{Entity: entity, Key: key, Skill: skill})
+}
+
+func (r *SeedReport) prune(counts *SeedCounts, entity, key string) {
+	counts.Deleted++
+	r.Pruned = append(r.Pruned, PrunedRecord{Entity: entity, Key: key})
+}
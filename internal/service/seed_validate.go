@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"strings"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/seedformat"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
+)
+
+// ValidateSeeds checks every seed file from source without touching the database: the file
+// syntax and schema, the domain rules of each record, and that every linked skill is defined
+// in the skills file. Problems are recorded as failures and unknown skills in the report;
+// the error is only set if a file could not be read at all.
+func ValidateSeeds(ctx context.Context, source port.SeedSource) (*SeedReport, error) {
+	report := &SeedReport{}
+	records, err := decodeSeedFiles(ctx, source, report)
+	if err != nil {
+		return report, err
+	}
+
+	// Skill references can only be checked against a skills file that was read successfully.
+	_, checkSkills := records["skills"]
+	skills := make(map[string]struct{})
+	checkRefs := func(entity, key string, names []string) {
+		if !checkSkills {
+			return
+		}
+		for _, name := range names {
+			if _, ok := skills[name]; !ok {
+				report.unknownSkill(entity, key, name)
+			}
+		}
+	}
+
+	if data, ok := records["skills"]; ok {
+		var seeds []skillSeed
+		if err := json.Unmarshal(data, &seeds); err != nil {
+			report.fail(&report.Skills, "skills", "", err)
+		}
+		for _, seed := range seeds {
+			skill, err := domain.NewSkill(seed.Name, seed.Category, seed.Proficiency, seed.LogoPath)
+			if err != nil {
+				report.fail(&report.Skills, "skill", strings.TrimSpace(seed.Name), err)
+				continue
+			}
+			skills[skill.Name] = struct{}{}
+		}
+	}
+
+	if data, ok := records["experiences"]; ok {
+		var seeds []experienceSeed
+		if err := json.Unmarshal(data, &seeds); err != nil {
+			report.fail(&report.Experiences, "experiences", "", err)
+		}
+		for _, seed := range seeds {
+			key := experienceKey(seed.CompanyName, seed.JobTitle)
+			if _, err := parseExperienceSeed(seed); err != nil {
+				report.fail(&report.Experiences, "experience", key, err)
+				continue
+			}
+			checkRefs("experience", key, seed.Skills)
+		}
+	}
+
+	if data, ok := records["achievements"]; ok {
+		var seeds []achievementSeed
+		if err := json.Unmarshal(data, &seeds); err != nil {
+			report.fail(&report.Achievements, "achievements", "", err)
+		}
+		for _, seed := range seeds {
+			key := strings.TrimSpace(seed.Title)
+			if _, err := parseAchievementSeed(seed); err != nil {
+				report.fail(&report.Achievements, "achievement", key, err)
+				continue
+			}
+			checkRefs("achievement", key, seed.Skills)
+		}
+	}
+
+	if data, ok := records["projects"]; ok {
+		var seeds []projectSeed
+		if err := json.Unmarshal(data, &seeds); err != nil {
+			report.fail(&report.Projects, "projects", "", err)
+		}
+		for _, seed := range seeds {
+			key := strings.TrimSpace(seed.Name)
+			if _, err := parseProjectSeed(seed); err != nil {
+				report.fail(&report.Projects, "project", key, err)
+				continue
+			}
+			checkRefs("project", key, seed.Skills)
+		}
+	}
+
+	return report, nil
+}
+
+// decodeSeedFiles reads and decodes the seed file of every entity, returning the records by
+// entity. A missing or invalid file is recorded in the report and left out; the error is only
+// set if a file could not be read at all.
+func decodeSeedFiles(ctx context.Context, source port.SeedSource, report *SeedReport) (map[string][]byte, error) {
+	counts := map[string]*SeedCounts{
+		"skills":       &report.Skills,
+		"experiences":  &report.Experiences,
+		"achievements": &report.Achievements,
+		"projects":     &report.Projects,
+	}
+
+	records := make(map[string][]byte)
+	for _, entity := range seedEntities {
+		file, content, err := readSeedFile(ctx, source, entity)
+		if errors.Is(err, fs.ErrNotExist) {
+			report.fail(counts[entity], entity, "", err)
+			continue
+		}
+		if err != nil {
+			return records, err
+		}
+
+		data, err := seedformat.Decode(entity, file, content)
+		var validationErr *seedformat.ValidationError
+		if errors.As(err, &validationErr) {
+			for _, issue := range validationErr.Issues {
+				report.failIssue(counts[entity], entity, issue)
+			}
+			continue
+		}
+		if err != nil {
+			return records, err
+		}
+		records[entity] = data
+	}
+	return records, nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mapSource is an in-memory port.SeedSource.
+type mapSource map[string]string
+
+func (m mapSource) ReadFile(_ context.Context, name string) ([]byte, error) {
+	content, ok := m[name]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return []byte(content), nil
+}
+
+func TestValidateSeeds(t *testing.T) {
+	valid := mapSource{
+		"skills.json":       `[{"name": "Go", "category": "Language", "proficiency": 90, "logo_url": ""}]`,
+		"experiences.yaml":  "- company_name: Acme\n  job_title: Engineer\n  location: Oslo\n  start_date: 2020-01-01\n  end_date: null\n  description: Built things\n  highlights: ''\n  skills: [Go]\n",
+		"achievements.json": `[]`,
+		"projects.json":     `[{"name": "CV", "description": "This site", "start_date": null, "end_date": null, "skills": ["Go"]}]`,
+	}
+
+	tests := []struct {
+		name             string
+		override         map[string]string
+		expectedFailures int
+		expectedUnknown  []string
+	}{
+		{"valid files", nil, 0, nil},
+		{
+			"unknown skill",
+			map[string]string{"projects.json": `[{"name": "CV", "description": "x", "start_date": null, "end_date": null, "skills": ["Rust"]}]`},
+			0, []string{"Rust"},
+		},
+		{
+			"end date before start date",
+			map[string]string{"projects.json": `[{"name": "CV", "description": "x", "start_date": "2024-01-01", "end_date": "2023-01-01", "skills": []}]`},
+			1, nil,
+		},
+		{
+			"schema violation",
+			map[string]string{"skills.json": `[{"name": "Go", "category": "Language", "proficiency": "high", "logo_url": ""}]`},
+			1, nil,
+		},
+		{"missing file", map[string]string{"achievements.json": ""}, 1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := mapSource{}
+			for name, content := range valid {
+				source[name] = content
+			}
+			for name, content := range tt.override {
+				if content == "" {
+					delete(source, name)
+					continue
+				}
+				source[name] = content
+			}
+
+			report, err := ValidateSeeds(context.Background(), source)
+			require.NoError(t, err)
+			assert.Len(t, report.Failures, tt.expectedFailures)
+
+			var unknown []string
+			for _, ref := range report.UnknownSkills {
+				unknown = append(unknown, ref.Skill)
+			}
+			assert.Equal(t, tt.expectedUnknown, unknown)
+		})
+	}
+}
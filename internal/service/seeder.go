@@ -4,47 +4,221 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/postgres"
-	"github.com/guillermoBallester/go-platform-cv/sql/data"
-	"log"
+	"fmt"
+	"io/fs"
+	"strings"
 	"time"
 
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/seedformat"
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/postgres"
 	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/port"
 	"github.com/jackc/pgx/v5"
 )
 
+// TxBeginner starts the transaction a seed run is applied in; *pgxpool.Pool is one.
+type TxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 // SeedService manages seeding data by providing methods to interact with different repository types.
 type SeedService struct {
+	db             TxBeginner
 	dbRepositories postgres.Repositories
+	source         port.SeedSource
 }
 
 func NewSeedService(
+	db TxBeginner,
 	dbRepositories postgres.Repositories,
+	source port.SeedSource,
 ) *SeedService {
 	return &SeedService{
+		db:             db,
 		dbRepositories: dbRepositories,
+		source:         source,
 	}
 }
 
-func (s *SeedService) Run(ctx context.Context) error {
+// seedEntities lists the seed files by entity name, in the order they are applied.
+// Skills go first so the other entities can link them.
+var seedEntities = []string{"skills", "experiences", "achievements", "projects"}
+
+// SeedOptions controls how a seed run is applied.
+type SeedOptions struct {
+	// Strict rolls back the whole run if any record fails or references an unknown skill.
+	Strict bool
+	// Prune soft-deletes records that no longer appear in the seed files. Seeding them again
+	// restores them.
+	Prune bool
+	// DryRun computes the full plan, including pruning, and rolls it back instead of committing.
+	DryRun bool
+}
+
+// Run applies every seed file inside a single transaction and reports what changed.
+// Records that fail to parse or validate are skipped and reported, unless opts.Strict is set,
+// in which case nothing is committed. Database errors always roll back the whole run.
+// With opts.DryRun the report describes the plan but the transaction is rolled back.
+func (s *SeedService) Run(ctx context.Context, opts SeedOptions) (*SeedReport, error) {
+	report := &SeedReport{DryRun: opts.DryRun}
+	run := &seedRun{
+		report: report,
+		seen:   make(map[string]map[string]struct{}),
+	}
+
 	tasks := []struct {
-		name string
-		fn   func(context.Context, []byte) error
-		data []byte
+		entity  string
+		fn      func(context.Context, []byte) error
+		counts  *SeedCounts
+		records []byte
 	}{
-		{"skills", s.SeedSkills, data.SkillsJSON},
-		{"experiences", s.SeedExperiences, data.ExperiencesJSON},
-		{"achievements", s.SeedAchievements, data.AchievementsJSON},
-		{"projects", s.SeedProjects, data.ProjectsJSON},
+		{"skills", run.seedSkills, &report.Skills, nil},
+		{"experiences", run.seedExperiences, &report.Experiences, nil},
+		{"achievements", run.seedAchievements, &report.Achievements, nil},
+		{"projects", run.seedProjects, &report.Projects, nil},
+	}
+
+	// Read and validate every file up front, so nothing is written when a file is malformed
+	// and the transaction is not held open during remote fetches. A missing or invalid file
+	// is reported, and its entity is neither seeded nor pruned.
+	for i, task := range tasks {
+		file, content, err := readSeedFile(ctx, s.source, task.entity)
+		if errors.Is(err, fs.ErrNotExist) {
+			report.fail(task.counts, task.entity, "", err)
+			continue
+		}
+		if err != nil {
+			return report, err
+		}
+
+		records, err := seedformat.Decode(task.entity, file, content)
+		var validationErr *seedformat.ValidationError
+		if errors.As(err, &validationErr) {
+			for _, issue := range validationErr.Issues {
+				report.failIssue(task.counts, task.entity, issue)
+			}
+			continue
+		}
+		if err != nil {
+			return report, err
+		}
+		tasks[i].records = records
+	}
+
+	if opts.Strict && report.HasProblems() {
+		return report, ErrSeedProblems
 	}
 
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return report, fmt.Errorf("begin seed transaction: %w", err)
+	}
+	// Rollback is a no-op once the transaction has been committed.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	run.repos = s.dbRepositories.WithTx(tx)
+	if err := run.repos.Audit.SetActor(ctx, domain.ActorSeed); err != nil {
+		return report, fmt.Errorf("set audit actor: %w", err)
+	}
 	for _, task := range tasks {
-		if err := task.fn(ctx, task.data); err != nil {
-			// We log the error but continue to the next task
-			log.Printf("Warning: could not seed %s: %v", task.name, err)
+		if task.records == nil {
+			continue
+		}
+		if err := task.fn(ctx, task.records); err != nil {
+			return report, fmt.Errorf("seeding %s: %w", task.entity, err)
+		}
+	}
+
+	if opts.Prune {
+		if err := run.prune(ctx); err != nil {
+			return report, fmt.Errorf("pruning: %w", err)
 		}
 	}
 
+	if opts.Strict && report.HasProblems() {
+		return report, ErrSeedProblems
+	}
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return report, fmt.Errorf("commit seed transaction: %w", err)
+	}
+
+	return report, nil
+}
+
+// readSeedFile reads the seed file of an entity in the first supported format found,
+// e.g. skills.json, then skills.yaml, skills.yml and skills.toml.
+func readSeedFile(ctx context.Context, source port.SeedSource, entity string) (string, []byte, error) {
+	for _, ext := range seedformat.Extensions {
+		file := entity + ext
+		content, err := source.ReadFile(ctx, file)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("reading seed file %s: %w", file, err)
+		}
+		return file, content, nil
+	}
+	return "", nil, fmt.Errorf("no %s seed file found (tried %s): %w",
+		entity, strings.Join(seedformat.Extensions, ", "), fs.ErrNotExist)
+}
+
+// seedRun holds the transaction-bound repositories and the report of a single seed run.
+type seedRun struct {
+	repos  *postgres.Repositories
+	report *SeedReport
+	// seen holds the natural keys found in each successfully parsed seed file, by entity.
+	seen map[string]map[string]struct{}
+}
+
+// markSeen records the natural key of a seed record, whether or not the record is valid,
+// so that pruning never deletes a record whose seed entry merely failed validation.
+func (r *seedRun) markSeen(entity, key string) {
+	if r.seen[entity] == nil {
+		r.seen[entity] = make(map[string]struct{})
+	}
+	r.seen[entity][key] = struct{}{}
+}
+
+// isOrphan returns true if the entity's seed file was parsed and does not contain the key.
+func (r *seedRun) isOrphan(entity, key string) bool {
+	keys, parsed := r.seen[entity]
+	if !parsed {
+		return false
+	}
+	_, ok := keys[key]
+	return !ok
+}
+
+// resolveSkills looks up skills by name, recording any name that does not exist in the report.
+func (r *seedRun) resolveSkills(ctx context.Context, entity, key string, skillNames []string) ([]int32, error) {
+	ids := make([]int32, 0, len(skillNames))
+	for _, skillName := range skillNames {
+		skill, err := r.repos.Skills.GetSkillByName(ctx, skillName)
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.report.unknownSkill(entity, key, skillName)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, skill.ID)
+	}
+	return ids, nil
+}
+
+// linkSkills links the resolved skill IDs to an entity using the given repository method.
+func linkSkills(ctx context.Context, id int32, skillIDs []int32, add func(context.Context, int32, int32) error) error {
+	for _, skillID := range skillIDs {
+		if err := add(ctx, id, skillID); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -58,69 +232,82 @@ type experienceSeed struct {
 	Description string   `json:"description"`
 	Highlights  string   `json:"highlights"`
 	Skills      []string `json:"skills"`
+	Visibility  string   `json:"visibility"`
 }
 
-// SeedExperiences upserts experience data - creates new experiences or updates existing ones.
-func (s *SeedService) SeedExperiences(ctx context.Context, data []byte) error {
+// seedExperiences upserts experience data - creates new experiences or updates existing ones.
+func (r *seedRun) seedExperiences(ctx context.Context, data []byte) error {
 	var seeds []experienceSeed
 	if err := json.Unmarshal(data, &seeds); err != nil {
-		return err
+		r.report.fail(&r.report.Experiences, "experiences", "", err)
+		return nil
 	}
 
+	r.seen["experiences"] = make(map[string]struct{})
 	for _, seed := range seeds {
-		exp, err := s.parseExperienceSeed(seed)
+		key := experienceKey(seed.CompanyName, seed.JobTitle)
+		r.markSeen("experiences", key)
+		exp, err := parseExperienceSeed(seed)
+		if err != nil {
+			r.report.fail(&r.report.Experiences, "experience", key, err)
+			continue
+		}
+
+		skillIDs, err := r.resolveSkills(ctx, "experience", key, seed.Skills)
 		if err != nil {
 			return err
 		}
 
-		existing, err := s.dbRepositories.Experiences.GetExperienceByCompanyAndTitle(ctx, seed.CompanyName, seed.JobTitle)
+		existing, err := r.repos.Experiences.GetExperienceByCompanyAndTitle(ctx, exp.CompanyName, exp.JobTitle)
 		if errors.Is(err, pgx.ErrNoRows) {
 			// Create new experience
-			expID, err := s.dbRepositories.Experiences.CreateExperience(ctx, exp)
+			expID, err := r.repos.Experiences.CreateExperience(ctx, exp)
 			if err != nil {
 				return err
 			}
-			if err := s.linkSkillsToExperience(ctx, expID, seed.Skills); err != nil {
+			if err := linkSkills(ctx, expID, skillIDs, r.repos.Experiences.AddSkillToExperience); err != nil {
 				return err
 			}
+			r.report.Experiences.Created++
 			continue
 		}
 		if err != nil {
 			return err
 		}
 
+		linked, err := r.repos.Experiences.GetSkillsForExperience(ctx, existing.ID)
+		if err != nil {
+			return err
+		}
+		if sameExperience(existing, exp) && sameSkills(linked, skillIDs) {
+			r.report.Experiences.Unchanged++
+			continue
+		}
+
 		// Update existing experience
 		exp.ID = existing.ID
-		if err := s.dbRepositories.Experiences.UpdateExperience(ctx, exp); err != nil {
+		if err := r.repos.Experiences.UpdateExperience(ctx, exp); err != nil {
 			return err
 		}
 
 		// Re-link skills (clear + re-add)
-		if err := s.dbRepositories.Experiences.ClearSkillsFromExperience(ctx, existing.ID); err != nil {
+		if err := r.repos.Experiences.ClearSkillsFromExperience(ctx, existing.ID); err != nil {
 			return err
 		}
-		if err := s.linkSkillsToExperience(ctx, existing.ID, seed.Skills); err != nil {
+		if err := linkSkills(ctx, existing.ID, skillIDs, r.repos.Experiences.AddSkillToExperience); err != nil {
 			return err
 		}
+		r.report.Experiences.Updated++
 	}
 	return nil
 }
 
-// linkSkillsToExperience links skills by name to an experience.
-func (s *SeedService) linkSkillsToExperience(ctx context.Context, expID int32, skillNames []string) error {
-	for _, skillName := range skillNames {
-		skill, err := s.dbRepositories.Skills.GetSkillByName(ctx, skillName)
-		if err != nil {
-			continue // Skip if skill not found
-		}
-		if err := s.dbRepositories.Experiences.AddSkillToExperience(ctx, expID, skill.ID); err != nil {
-			return err
-		}
-	}
-	return nil
+// experienceKey returns the natural key of an experience: its company name and job title.
+func experienceKey(companyName, jobTitle string) string {
+	return strings.TrimSpace(companyName) + " / " + strings.TrimSpace(jobTitle)
 }
 
-func (s *SeedService) parseExperienceSeed(seed experienceSeed) (domain.Experience, error) {
+func parseExperienceSeed(seed experienceSeed) (domain.Experience, error) {
 	startDate, err := parseDate(seed.StartDate)
 	if err != nil {
 		return domain.Experience{}, err
@@ -135,7 +322,7 @@ func (s *SeedService) parseExperienceSeed(seed experienceSeed) (domain.Experienc
 		endDate = &parsed
 	}
 
-	return domain.NewExperience(
+	exp, err := domain.NewExperience(
 		seed.CompanyName,
 		seed.JobTitle,
 		seed.Location,
@@ -144,6 +331,14 @@ func (s *SeedService) parseExperienceSeed(seed experienceSeed) (domain.Experienc
 		seed.Description,
 		seed.Highlights,
 	)
+	if err != nil {
+		return domain.Experience{}, err
+	}
+	exp.Visibility = seedVisibility(seed.Visibility)
+	if err := exp.Validate(); err != nil {
+		return domain.Experience{}, err
+	}
+	return exp, nil
 }
 
 // achievementSeed represents the JSON structure for seeding achievements.
@@ -154,69 +349,77 @@ type achievementSeed struct {
 	ExperienceID *int32   `json:"experience_id"`
 	ProjectID    *int32   `json:"project_id"`
 	Skills       []string `json:"skills"`
+	Visibility   string   `json:"visibility"`
 }
 
-// SeedAchievements upserts achievement data - creates new achievements or updates existing ones.
-func (s *SeedService) SeedAchievements(ctx context.Context, data []byte) error {
+// seedAchievements upserts achievement data - creates new achievements or updates existing ones.
+func (r *seedRun) seedAchievements(ctx context.Context, data []byte) error {
 	var seeds []achievementSeed
 	if err := json.Unmarshal(data, &seeds); err != nil {
-		return err
+		r.report.fail(&r.report.Achievements, "achievements", "", err)
+		return nil
 	}
 
+	r.seen["achievements"] = make(map[string]struct{})
 	for _, seed := range seeds {
-		ach, err := s.parseAchievementSeed(seed)
+		key := strings.TrimSpace(seed.Title)
+		r.markSeen("achievements", key)
+		ach, err := parseAchievementSeed(seed)
+		if err != nil {
+			r.report.fail(&r.report.Achievements, "achievement", key, err)
+			continue
+		}
+
+		skillIDs, err := r.resolveSkills(ctx, "achievement", key, seed.Skills)
 		if err != nil {
 			return err
 		}
 
-		existing, err := s.dbRepositories.Achievements.GetAchievementByTitle(ctx, seed.Title)
+		existing, err := r.repos.Achievements.GetAchievementByTitle(ctx, ach.Title)
 		if errors.Is(err, pgx.ErrNoRows) {
 			// Create new achievement
-			achID, err := s.dbRepositories.Achievements.CreateAchievement(ctx, ach)
+			achID, err := r.repos.Achievements.CreateAchievement(ctx, ach)
 			if err != nil {
 				return err
 			}
-			if err := s.linkSkillsToAchievement(ctx, achID, seed.Skills); err != nil {
+			if err := linkSkills(ctx, achID, skillIDs, r.repos.Achievements.AddSkillToAchievement); err != nil {
 				return err
 			}
+			r.report.Achievements.Created++
 			continue
 		}
 		if err != nil {
 			return err
 		}
 
+		linked, err := r.repos.Achievements.GetSkillsForAchievement(ctx, existing.ID)
+		if err != nil {
+			return err
+		}
+		if sameAchievement(existing, ach) && sameSkills(linked, skillIDs) {
+			r.report.Achievements.Unchanged++
+			continue
+		}
+
 		// Update existing achievement
 		ach.ID = existing.ID
-		if err := s.dbRepositories.Achievements.UpdateAchievement(ctx, ach); err != nil {
+		if err := r.repos.Achievements.UpdateAchievement(ctx, ach); err != nil {
 			return err
 		}
 
 		// Re-link skills (clear + re-add)
-		if err := s.dbRepositories.Achievements.ClearSkillsFromAchievement(ctx, existing.ID); err != nil {
-			return err
-		}
-		if err := s.linkSkillsToAchievement(ctx, existing.ID, seed.Skills); err != nil {
+		if err := r.repos.Achievements.ClearSkillsFromAchievement(ctx, existing.ID); err != nil {
 			return err
 		}
-	}
-	return nil
-}
-
-// linkSkillsToAchievement links skills by name to an achievement.
-func (s *SeedService) linkSkillsToAchievement(ctx context.Context, achID int32, skillNames []string) error {
-	for _, skillName := range skillNames {
-		skill, err := s.dbRepositories.Skills.GetSkillByName(ctx, skillName)
-		if err != nil {
-			continue // Skip if skill not found
-		}
-		if err := s.dbRepositories.Achievements.AddSkillToAchievement(ctx, achID, skill.ID); err != nil {
+		if err := linkSkills(ctx, existing.ID, skillIDs, r.repos.Achievements.AddSkillToAchievement); err != nil {
 			return err
 		}
+		r.report.Achievements.Updated++
 	}
 	return nil
 }
 
-func (s *SeedService) parseAchievementSeed(seed achievementSeed) (domain.Achievement, error) {
+func parseAchievementSeed(seed achievementSeed) (domain.Achievement, error) {
 	var date *time.Time
 	if seed.Date != nil {
 		parsed, err := parseDate(*seed.Date)
@@ -226,13 +429,21 @@ func (s *SeedService) parseAchievementSeed(seed achievementSeed) (domain.Achieve
 		date = &parsed
 	}
 
-	return domain.NewAchievement(
+	ach, err := domain.NewAchievement(
 		seed.Title,
 		seed.Description,
 		date,
 		seed.ExperienceID,
 		seed.ProjectID,
 	)
+	if err != nil {
+		return domain.Achievement{}, err
+	}
+	ach.Visibility = seedVisibility(seed.Visibility)
+	if err := ach.Validate(); err != nil {
+		return domain.Achievement{}, err
+	}
+	return ach, nil
 }
 
 // projectSeed represents the JSON structure for seeding projects.
@@ -242,69 +453,77 @@ type projectSeed struct {
 	StartDate   *string  `json:"start_date"`
 	EndDate     *string  `json:"end_date"`
 	Skills      []string `json:"skills"`
+	Visibility  string   `json:"visibility"`
 }
 
-// SeedProjects upserts project data - creates new projects or updates existing ones.
-func (s *SeedService) SeedProjects(ctx context.Context, data []byte) error {
+// seedProjects upserts project data - creates new projects or updates existing ones.
+func (r *seedRun) seedProjects(ctx context.Context, data []byte) error {
 	var seeds []projectSeed
 	if err := json.Unmarshal(data, &seeds); err != nil {
-		return err
+		r.report.fail(&r.report.Projects, "projects", "", err)
+		return nil
 	}
 
+	r.seen["projects"] = make(map[string]struct{})
 	for _, seed := range seeds {
-		proj, err := s.parseProjectSeed(seed)
+		key := strings.TrimSpace(seed.Name)
+		r.markSeen("projects", key)
+		proj, err := parseProjectSeed(seed)
+		if err != nil {
+			r.report.fail(&r.report.Projects, "project", key, err)
+			continue
+		}
+
+		skillIDs, err := r.resolveSkills(ctx, "project", key, seed.Skills)
 		if err != nil {
 			return err
 		}
 
-		existing, err := s.dbRepositories.Projects.GetProjectByName(ctx, seed.Name)
+		existing, err := r.repos.Projects.GetProjectByName(ctx, proj.Name)
 		if errors.Is(err, pgx.ErrNoRows) {
 			// Create new project
-			projID, err := s.dbRepositories.Projects.CreateProject(ctx, proj)
+			projID, err := r.repos.Projects.CreateProject(ctx, proj)
 			if err != nil {
 				return err
 			}
-			if err := s.linkSkillsToProject(ctx, projID, seed.Skills); err != nil {
+			if err := linkSkills(ctx, projID, skillIDs, r.repos.Projects.AddSkillToProject); err != nil {
 				return err
 			}
+			r.report.Projects.Created++
 			continue
 		}
 		if err != nil {
 			return err
 		}
 
+		linked, err := r.repos.Projects.GetSkillsForProject(ctx, existing.ID)
+		if err != nil {
+			return err
+		}
+		if sameProject(existing, proj) && sameSkills(linked, skillIDs) {
+			r.report.Projects.Unchanged++
+			continue
+		}
+
 		// Update existing project
 		proj.ID = existing.ID
-		if err := s.dbRepositories.Projects.UpdateProject(ctx, proj); err != nil {
+		if err := r.repos.Projects.UpdateProject(ctx, proj); err != nil {
 			return err
 		}
 
 		// Re-link skills (clear + re-add)
-		if err := s.dbRepositories.Projects.ClearSkillsFromProject(ctx, existing.ID); err != nil {
+		if err := r.repos.Projects.ClearSkillsFromProject(ctx, existing.ID); err != nil {
 			return err
 		}
-		if err := s.linkSkillsToProject(ctx, existing.ID, seed.Skills); err != nil {
+		if err := linkSkills(ctx, existing.ID, skillIDs, r.repos.Projects.AddSkillToProject); err != nil {
 			return err
 		}
+		r.report.Projects.Updated++
 	}
 	return nil
 }
 
-// linkSkillsToProject links skills by name to a project.
-func (s *SeedService) linkSkillsToProject(ctx context.Context, projID int32, skillNames []string) error {
-	for _, skillName := range skillNames {
-		skill, err := s.dbRepositories.Skills.GetSkillByName(ctx, skillName)
-		if err != nil {
-			continue // Skip if skill not found
-		}
-		if err := s.dbRepositories.Projects.AddSkillToProject(ctx, projID, skill.ID); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (s *SeedService) parseProjectSeed(seed projectSeed) (domain.Project, error) {
+func parseProjectSeed(seed projectSeed) (domain.Project, error) {
 	var startDate *time.Time
 	if seed.StartDate != nil {
 		parsed, err := parseDate(*seed.StartDate)
@@ -323,56 +542,169 @@ func (s *SeedService) parseProjectSeed(seed projectSeed) (domain.Project, error)
 		endDate = &parsed
 	}
 
-	return domain.NewProject(
+	proj, err := domain.NewProject(
 		seed.Name,
 		seed.Description,
 		startDate,
 		endDate,
 	)
+	if err != nil {
+		return domain.Project{}, err
+	}
+	proj.Visibility = seedVisibility(seed.Visibility)
+	if err := proj.Validate(); err != nil {
+		return domain.Project{}, err
+	}
+	return proj, nil
 }
 
 func parseDate(s string) (time.Time, error) {
 	return time.Parse("2006-01-02", s)
 }
 
+// seedVisibility returns the visibility of a seed record, public if it has none.
+func seedVisibility(visibility string) domain.Visibility {
+	if visibility == "" {
+		return domain.VisibilityPublic
+	}
+	return domain.Visibility(visibility)
+}
+
 // skillSeed represents the JSON structure for seeding skills.
 type skillSeed struct {
 	Name        string `json:"name"`
 	Category    string `json:"category"`
 	Proficiency int32  `json:"proficiency"`
 	LogoPath    string `json:"logo_url"`
+	Visibility  string `json:"visibility"`
 }
 
-// SeedSkills upserts skills data - creates new skills or updates existing ones.
-func (s *SeedService) SeedSkills(ctx context.Context, data []byte) error {
+func parseSkillSeed(seed skillSeed) (domain.Skill, error) {
+	skill, err := domain.NewSkill(seed.Name, seed.Category, seed.Proficiency, seed.LogoPath)
+	if err != nil {
+		return domain.Skill{}, err
+	}
+	skill.Visibility = seedVisibility(seed.Visibility)
+	if err := skill.Validate(); err != nil {
+		return domain.Skill{}, err
+	}
+	return skill, nil
+}
+
+// seedSkills upserts skills data - creates new skills or updates existing ones.
+func (r *seedRun) seedSkills(ctx context.Context, data []byte) error {
 	var seeds []skillSeed
 	if err := json.Unmarshal(data, &seeds); err != nil {
-		return err
+		r.report.fail(&r.report.Skills, "skills", "", err)
+		return nil
 	}
 
+	r.seen["skills"] = make(map[string]struct{})
 	for _, seed := range seeds {
-		skill, err := domain.NewSkill(seed.Name, seed.Category, seed.Proficiency, seed.LogoPath)
+		key := strings.TrimSpace(seed.Name)
+		r.markSeen("skills", key)
+		skill, err := parseSkillSeed(seed)
 		if err != nil {
-			return err
+			r.report.fail(&r.report.Skills, "skill", key, err)
+			continue
 		}
 
-		existing, err := s.dbRepositories.Skills.GetSkillByName(ctx, seed.Name)
+		existing, err := r.repos.Skills.GetSkillByName(ctx, skill.Name)
 		if errors.Is(err, pgx.ErrNoRows) {
 			// Create new skill
-			if err := s.dbRepositories.Skills.CreateSkill(ctx, skill); err != nil {
+			if err := r.repos.Skills.CreateSkill(ctx, skill); err != nil {
 				return err
 			}
+			r.report.Skills.Created++
 			continue
 		}
 		if err != nil {
 			return err
 		}
 
+		if sameSkill(existing, skill) {
+			r.report.Skills.Unchanged++
+			continue
+		}
+
 		// Update existing skill
 		skill.ID = existing.ID
-		if err := s.dbRepositories.Skills.UpdateSkill(ctx, skill); err != nil {
+		if err := r.repos.Skills.UpdateSkill(ctx, skill); err != nil {
+			return err
+		}
+		r.report.Skills.Updated++
+	}
+	return nil
+}
+
+// prune soft-deletes records that exist in the database but no longer appear in the seed files.
+// Entities whose seed file could not be parsed are left untouched.
+func (r *seedRun) prune(ctx context.Context) error {
+	achievements, err := r.repos.Achievements.GetAchievements(ctx)
+	if err != nil {
+		return err
+	}
+	stored := make([]storedRecord, len(achievements))
+	for i, ach := range achievements {
+		stored[i] = storedRecord{ID: ach.ID, Key: ach.Title, Deleted: ach.DeletedAt != nil}
+	}
+	if err := r.pruneRecords(ctx, "achievements", "achievement", &r.report.Achievements, stored, r.repos.Achievements.DeleteAchievement); err != nil {
+		return err
+	}
+
+	projects, err := r.repos.Projects.GetProjects(ctx)
+	if err != nil {
+		return err
+	}
+	stored = make([]storedRecord, len(projects))
+	for i, proj := range projects {
+		stored[i] = storedRecord{ID: proj.ID, Key: proj.Name, Deleted: proj.DeletedAt != nil}
+	}
+	if err := r.pruneRecords(ctx, "projects", "project", &r.report.Projects, stored, r.repos.Projects.DeleteProject); err != nil {
+		return err
+	}
+
+	experiences, err := r.repos.Experiences.GetExperiences(ctx)
+	if err != nil {
+		return err
+	}
+	stored = make([]storedRecord, len(experiences))
+	for i, exp := range experiences {
+		stored[i] = storedRecord{ID: exp.ID, Key: experienceKey(exp.CompanyName, exp.JobTitle), Deleted: exp.DeletedAt != nil}
+	}
+	if err := r.pruneRecords(ctx, "experiences", "experience", &r.report.Experiences, stored, r.repos.Experiences.DeleteExperience); err != nil {
+		return err
+	}
+
+	skills, err := r.repos.Skills.GetSkills(ctx)
+	if err != nil {
+		return err
+	}
+	stored = make([]storedRecord, len(skills))
+	for i, skill := range skills {
+		stored[i] = storedRecord{ID: skill.ID, Key: skill.Name, Deleted: skill.DeletedAt != nil}
+	}
+	return r.pruneRecords(ctx, "skills", "skill", &r.report.Skills, stored, r.repos.Skills.DeleteSkill)
+}
+
+// storedRecord is a record in the database as pruning sees it.
+type storedRecord struct {
+	ID      int32
+	Key     string
+	Deleted bool
+}
+
+// pruneRecords deletes the stored records of an entity that are orphans, and reports them as
+// kind. Records already deleted are skipped, so they are only reported once.
+func (r *seedRun) pruneRecords(ctx context.Context, entity, kind string, counts *SeedCounts, stored []storedRecord, remove func(context.Context, int32) error) error {
+	for _, record := range stored {
+		if record.Deleted || !r.isOrphan(entity, record.Key) {
+			continue
+		}
+		if err := remove(ctx, record.ID); err != nil {
 			return err
 		}
+		r.report.prune(counts, kind, record.Key)
 	}
 	return nil
 }
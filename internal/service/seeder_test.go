@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneRecords(t *testing.T) {
+	stored := []storedRecord{
+		{ID: 1, Key: "Go"},
+		{ID: 2, Key: "Cobol"},
+		{ID: 3, Key: "Fortran", Deleted: true},
+		{ID: 4, Key: "Broken"},
+	}
+
+	tests := []struct {
+		name    string
+		seen    []string
+		parsed  bool
+		removed []int32
+	}{
+		{"orphans are removed", []string{"Go", "Broken"}, true, []int32{2}},
+		{"every record in the seed file", []string{"Go", "Cobol", "Broken"}, true, nil},
+		{"empty seed file", nil, true, []int32{1, 2, 4}},
+		{"seed file not parsed", nil, false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := &seedRun{report: &SeedReport{}, seen: make(map[string]map[string]struct{})}
+			if tt.parsed {
+				run.seen["skills"] = make(map[string]struct{})
+			}
+			for _, key := range tt.seen {
+				run.markSeen("skills", key)
+			}
+
+			var removed []int32
+			err := run.pruneRecords(context.Background(), "skills", "skill", &run.report.Skills, stored,
+				func(_ context.Context, id int32) error {
+					removed = append(removed, id)
+					return nil
+				})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.removed, removed)
+			assert.Equal(t, len(tt.removed), run.report.Skills.Deleted)
+			assert.Len(t, run.report.Pruned, len(tt.removed))
+		})
+	}
+}
+
+func TestPruneRecordsStopsOnError(t *testing.T) {
+	run := &seedRun{report: &SeedReport{}, seen: map[string]map[string]struct{}{"skills": {}}}
+	stored := []storedRecord{{ID: 1, Key: "Go"}, {ID: 2, Key: "Rust"}}
+
+	calls := 0
+	err := run.pruneRecords(context.Background(), "skills", "skill", &run.report.Skills, stored,
+		func(context.Context, int32) error {
+			calls++
+			return errors.New("connection lost")
+		})
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, run.report.Pruned)
+}
+
+// fakeTx is a transaction on which no skill exists yet. It records the inserts and how it ended.
+type fakeTx struct {
+	pgx.Tx
+	inserts    int
+	committed  bool
+	rolledBack bool
+}
+
+func (tx *fakeTx) Begin(context.Context) (pgx.Tx, error) {
+	return tx, nil
+}
+
+func (tx *fakeTx) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+
+func (tx *fakeTx) QueryRow(_ context.Context, sql string, _ ...any) pgx.Row {
+	if strings.Contains(sql, "INSERT") {
+		tx.inserts++
+		return fakeRow{}
+	}
+	return fakeRow{err: pgx.ErrNoRows}
+}
+
+func (tx *fakeTx) Commit(context.Context) error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback(context.Context) error {
+	if !tx.committed {
+		tx.rolledBack = true
+	}
+	return nil
+}
+
+type fakeRow struct{ err error }
+
+func (r fakeRow) Scan(...any) error { return r.err }
+
+func TestSeedRunDryRun(t *testing.T) {
+	source := mapSource{
+		"skills.json": `[{"name": "Go", "category": "Language", "proficiency": 90, "logo_url": ""}]`,
+	}
+
+	tests := []struct {
+		name      string
+		dryRun    bool
+		committed bool
+	}{
+		{"dry run rolls back", true, false},
+		{"run commits", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeTx{}
+			svc := NewSeedService(tx, *postgres.NewRepositories(nil, nil), source)
+
+			report, err := svc.Run(context.Background(), SeedOptions{DryRun: tt.dryRun})
+			require.NoError(t, err)
+
+			// The plan is the same either way; only the outcome differs.
+			assert.Equal(t, tt.dryRun, report.DryRun)
+			assert.Equal(t, 1, report.Skills.Created)
+			assert.Equal(t, 1, tx.inserts)
+			assert.Equal(t, tt.committed, tx.committed)
+			assert.Equal(t, !tt.committed, tx.rolledBack)
+		})
+	}
+}
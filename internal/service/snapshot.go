@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/adapter/storage/postgres"
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// snapshotFormat is the version of the snapshot document layout. Bump it, and keep decoding
+// the older layouts, whenever the document changes incompatibly.
+const snapshotFormat = 1
+
+// snapshotDocument is how a snapshot is stored: the full CV, with the skill links of every
+// entity, tagged with the layout it was written in.
+type snapshotDocument struct {
+	Format int       `json:"format"`
+	CV     domain.CV `json:"cv"`
+}
+
+// SnapshotService takes, lists and reads back snapshots of the CV.
+type SnapshotService struct {
+	db             *pgxpool.Pool
+	dbRepositories postgres.Repositories
+}
+
+func NewSnapshotService(db *pgxpool.Pool, dbRepositories postgres.Repositories) *SnapshotService {
+	return &SnapshotService{
+		db:             db,
+		dbRepositories: dbRepositories,
+	}
+}
+
+// Take freezes the current CV into a new snapshot, optionally labelled. The CV is read from
+// the primary, bypassing the cache, in one repeatable read transaction so the snapshot is
+// consistent even while a seed run is writing.
+func (s *SnapshotService) Take(ctx context.Context, label string) (domain.SnapshotInfo, error) {
+	if err := domain.ValidateSnapshotLabel(label); err != nil {
+		return domain.SnapshotInfo{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead})
+	if err != nil {
+		return domain.SnapshotInfo{}, fmt.Errorf("begin snapshot transaction: %w", err)
+	}
+	// Rollback is a no-op once the transaction has been committed.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	repos := s.dbRepositories.WithTx(tx)
+	cv, err := NewCVService(repos.Public()).GetCV(ctx)
+	if err != nil {
+		return domain.SnapshotInfo{}, fmt.Errorf("read cv: %w", err)
+	}
+	document, err := encodeSnapshot(cv)
+	if err != nil {
+		return domain.SnapshotInfo{}, err
+	}
+	info, err := repos.Snapshots.CreateSnapshot(ctx, label, document)
+	if err != nil {
+		return domain.SnapshotInfo{}, fmt.Errorf("store snapshot: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return domain.SnapshotInfo{}, fmt.Errorf("commit snapshot: %w", err)
+	}
+	return info, nil
+}
+
+// List returns every snapshot, newest first.
+func (s *SnapshotService) List(ctx context.Context) ([]domain.SnapshotInfo, error) {
+	return s.dbRepositories.Snapshots.ListSnapshots(ctx)
+}
+
+// Document returns the stored document of the snapshot named by ref: a version number, or
+// else a label. It returns domain.ErrSnapshotNotFound if there is no such snapshot.
+func (s *SnapshotService) Document(ctx context.Context, ref string) (domain.SnapshotInfo, []byte, error) {
+	if version, err := strconv.ParseInt(ref, 10, 32); err == nil {
+		return s.dbRepositories.Snapshots.GetSnapshot(ctx, int32(version))
+	}
+	return s.dbRepositories.Snapshots.GetSnapshotByLabel(ctx, ref)
+}
+
+// Get returns the snapshot named by ref, as Document does, with its CV decoded.
+func (s *SnapshotService) Get(ctx context.Context, ref string) (domain.Snapshot, error) {
+	info, document, err := s.Document(ctx, ref)
+	if err != nil {
+		return domain.Snapshot{}, err
+	}
+	cv, err := decodeSnapshot(document)
+	if err != nil {
+		return domain.Snapshot{}, fmt.Errorf("snapshot %d: %w", info.Version, err)
+	}
+	return domain.Snapshot{SnapshotInfo: info, CV: cv}, nil
+}
+
+// Diff returns what changed in the CV from snapshot from to snapshot to.
+func (s *SnapshotService) Diff(ctx context.Context, from, to string) ([]CVChange, error) {
+	fromSnapshot, err := s.Get(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot %s: %w", from, err)
+	}
+	toSnapshot, err := s.Get(ctx, to)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot %s: %w", to, err)
+	}
+	return DiffCV(fromSnapshot.CV, toSnapshot.CV), nil
+}
+
+func encodeSnapshot(cv domain.CV) ([]byte, error) {
+	return json.Marshal(snapshotDocument{Format: snapshotFormat, CV: cv})
+}
+
+func decodeSnapshot(document []byte) (domain.CV, error) {
+	var doc snapshotDocument
+	if err := json.Unmarshal(document, &doc); err != nil {
+		return domain.CV{}, fmt.Errorf("decode snapshot: %w", err)
+	}
+	if doc.Format != snapshotFormat {
+		return domain.CV{}, fmt.Errorf("unsupported snapshot format %d", doc.Format)
+	}
+	return doc.CV, nil
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+)
+
+// Kinds of CVChange.
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// CVChange describes an entity added to, removed from or changed in a CV. Entities are
+// matched by their natural key, as in the seed files, so IDs and timestamps do not count.
+type CVChange struct {
+	Entity string `json:"entity"`
+	Key    string `json:"key"`
+	Change string `json:"change"`
+	// Fields lists the fields of a changed entity that differ.
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is the value of a field before and after a change. Dates are formatted as in
+// the seed files, and skills as a sorted list of names.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// DiffCV returns the changes from one CV to another: skills, experiences, achievements and
+// projects in turn, each in the order of the newer CV, followed by its removed entities.
+func DiffCV(from, to domain.CV) []CVChange {
+	var changes []CVChange
+	changes = append(changes, diffRecords(domain.EntitySkill, skillRecords(from), skillRecords(to))...)
+	changes = append(changes, diffRecords(domain.EntityExperience, experienceRecords(from), experienceRecords(to))...)
+	changes = append(changes, diffRecords(domain.EntityAchievement, achievementRecords(from), achievementRecords(to))...)
+	changes = append(changes, diffRecords(domain.EntityProject, projectRecords(from), projectRecords(to))...)
+	return changes
+}
+
+// diffRecord is an entity reduced to its key and its compared fields, in a fixed order.
+type diffRecord struct {
+	key    string
+	fields []diffField
+}
+
+type diffField struct {
+	name  string
+	value string
+}
+
+func diffRecords(entity string, from, to []diffRecord) []CVChange {
+	old := make(map[string]diffRecord, len(from))
+	for _, r := range from {
+		old[r.key] = r
+	}
+
+	var changes []CVChange
+	current := make(map[string]bool, len(to))
+	for _, r := range to {
+		current[r.key] = true
+		before, ok := old[r.key]
+		if !ok {
+			changes = append(changes, CVChange{Entity: entity, Key: r.key, Change: ChangeAdded})
+			continue
+		}
+		var fields []FieldChange
+		for i, f := range r.fields {
+			if before.fields[i].value != f.value {
+				fields = append(fields, FieldChange{Field: f.name, From: before.fields[i].value, To: f.value})
+			}
+		}
+		if len(fields) > 0 {
+			changes = append(changes, CVChange{Entity: entity, Key: r.key, Change: ChangeChanged, Fields: fields})
+		}
+	}
+	for _, r := range from {
+		if !current[r.key] {
+			changes = append(changes, CVChange{Entity: entity, Key: r.key, Change: ChangeRemoved})
+		}
+	}
+	return changes
+}
+
+func skillRecords(cv domain.CV) []diffRecord {
+	records := make([]diffRecord, len(cv.Skills))
+	for i, s := range cv.Skills {
+		records[i] = diffRecord{key: s.Name, fields: []diffField{
+			{"category", s.Category},
+			{"proficiency", strconv.Itoa(int(s.Proficiency))},
+			{"logo_url", s.LogoPath},
+		}}
+	}
+	return records
+}
+
+func experienceRecords(cv domain.CV) []diffRecord {
+	records := make([]diffRecord, len(cv.Experiences))
+	for i, e := range cv.Experiences {
+		records[i] = diffRecord{key: experienceKey(e.CompanyName, e.JobTitle), fields: []diffField{
+			{"location", e.Location},
+			{"start_date", formatDiffDate(&e.StartDate)},
+			{"end_date", formatDiffDate(e.EndDate)},
+			{"description", e.Description},
+			{"highlights", e.Highlights},
+			{"skills", skillNames(e.Skills)},
+		}}
+	}
+	return records
+}
+
+// achievementRecords resolves the experience and project of each achievement to their keys,
+// as their IDs may differ between snapshots.
+func achievementRecords(cv domain.CV) []diffRecord {
+	experiences := make(map[int32]string, len(cv.Experiences))
+	for _, e := range cv.Experiences {
+		experiences[e.ID] = experienceKey(e.CompanyName, e.JobTitle)
+	}
+	projects := make(map[int32]string, len(cv.Projects))
+	for _, p := range cv.Projects {
+		projects[p.ID] = p.Name
+	}
+
+	records := make([]diffRecord, len(cv.Achievements))
+	for i, a := range cv.Achievements {
+		var experience, project string
+		if a.ExperienceID != nil {
+			experience = experiences[*a.ExperienceID]
+		}
+		if a.ProjectID != nil {
+			project = projects[*a.ProjectID]
+		}
+		records[i] = diffRecord{key: a.Title, fields: []diffField{
+			{"description", a.Description},
+			{"date", formatDiffDate(a.Date)},
+			{"experience", experience},
+			{"project", project},
+			{"skills", skillNames(a.Skills)},
+		}}
+	}
+	return records
+}
+
+func projectRecords(cv domain.CV) []diffRecord {
+	records := make([]diffRecord, len(cv.Projects))
+	for i, p := range cv.Projects {
+		records[i] = diffRecord{key: p.Name, fields: []diffField{
+			{"description", p.Description},
+			{"start_date", formatDiffDate(p.StartDate)},
+			{"end_date", formatDiffDate(p.EndDate)},
+			{"skills", skillNames(p.Skills)},
+		}}
+	}
+	return records
+}
+
+func formatDiffDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+func skillNames(skills []domain.Skill) string {
+	names := make([]string, len(skills))
+	for i, s := range skills {
+		names[i] = s.Name
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guillermoBallester/go-platform-cv/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffCV(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	goSkill := domain.Skill{ID: 1, Name: "Go", Category: "language", Proficiency: 90}
+	pgSkill := domain.Skill{ID: 2, Name: "Postgres", Category: "database", Proficiency: 70}
+
+	base := func() domain.CV {
+		expID, projID := int32(10), int32(20)
+		return domain.CV{
+			Skills: []domain.Skill{goSkill, pgSkill},
+			Experiences: []domain.Experience{{
+				ID: 10, CompanyName: "Acme", JobTitle: "Engineer", StartDate: start,
+				Description: "Work", Skills: []domain.Skill{goSkill},
+			}},
+			Achievements: []domain.Achievement{{
+				ID: 30, Title: "Shipped", Description: "It", ExperienceID: &expID, ProjectID: &projID,
+			}},
+			Projects: []domain.Project{{ID: 20, Name: "CV", Description: "This"}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(cv *domain.CV)
+		expected []CVChange
+	}{
+		{"identical", func(cv *domain.CV) {}, nil},
+		{
+			"ids and timestamps are ignored",
+			func(cv *domain.CV) {
+				expID := int32(11)
+				cv.Experiences[0].ID = expID
+				cv.Experiences[0].UpdatedAt = time.Now()
+				cv.Achievements[0].ExperienceID = &expID
+			},
+			nil,
+		},
+		{
+			"changed fields",
+			func(cv *domain.CV) {
+				cv.Skills[0].Proficiency = 95
+				cv.Experiences[0].EndDate = &end
+				cv.Experiences[0].Skills = []domain.Skill{pgSkill, goSkill}
+			},
+			[]CVChange{
+				{Entity: "skill", Key: "Go", Change: ChangeChanged, Fields: []FieldChange{
+					{Field: "proficiency", From: "90", To: "95"},
+				}},
+				{Entity: "experience", Key: "Acme / Engineer", Change: ChangeChanged, Fields: []FieldChange{
+					{Field: "end_date", From: "", To: "2022-06-01"},
+					{Field: "skills", From: "Go", To: "Go, Postgres"},
+				}},
+			},
+		},
+		{
+			"added and removed",
+			func(cv *domain.CV) {
+				cv.Projects = []domain.Project{{ID: 21, Name: "Blog", Description: "Posts"}}
+				cv.Achievements[0].ProjectID = nil
+			},
+			[]CVChange{
+				{Entity: "achievement", Key: "Shipped", Change: ChangeChanged, Fields: []FieldChange{
+					{Field: "project", From: "CV", To: ""},
+				}},
+				{Entity: "project", Key: "Blog", Change: ChangeAdded},
+				{Entity: "project", Key: "CV", Change: ChangeRemoved},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to := base()
+			tt.mutate(&to)
+			assert.Equal(t, tt.expected, DiffCV(base(), to))
+		})
+	}
+}
+
+func TestSnapshotDocument(t *testing.T) {
+	end := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	cv := domain.CV{
+		Skills:      []domain.Skill{{ID: 1, Name: "Go", Category: "language", Proficiency: 90}},
+		Experiences: []domain.Experience{{ID: 2, CompanyName: "Acme", EndDate: &end}},
+	}
+
+	document, err := encodeSnapshot(cv)
+	require.NoError(t, err)
+	decoded, err := decodeSnapshot(document)
+	require.NoError(t, err)
+	assert.Equal(t, cv, decoded)
+
+	_, err = decodeSnapshot([]byte(`{"format":2,"cv":{}}`))
+	assert.ErrorContains(t, err, "unsupported snapshot format 2")
+}
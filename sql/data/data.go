@@ -1,15 +1,13 @@
 package data
 
-import _ "embed"
+import "embed"
 
-//go:embed skills.json
-var SkillsJSON []byte
+// FS holds the default seed files, embedded at compile time.
+//
+//go:embed *.json
+var FS embed.FS
 
-//go:embed experiences.json
-var ExperiencesJSON []byte
-
-//go:embed achievements.json
-var AchievementsJSON []byte
-
-//go:embed projects.json
-var ProjectsJSON []byte
+// Schemas holds the JSON Schema published for each seed file, e.g. schemas/skills.schema.json.
+//
+//go:embed schemas/*.schema.json
+var Schemas embed.FS
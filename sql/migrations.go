@@ -1,48 +1,190 @@
 package sql
 
 import (
-	"database/sql"
+	"context"
 	"embed"
 	"errors"
 	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
-	"io/fs"
+	"github.com/pressly/goose/v3/lock"
 )
 
 //go:embed migrations/*.sql
 var embedMigrations embed.FS
 
-func setup() error {
+// MigrationsDir is the on-disk directory of the embedded migrations, where new ones are created.
+const MigrationsDir = "sql/migrations"
+
+// undefinedTable is the Postgres SQLSTATE for a missing table.
+const undefinedTable = "42P01"
+
+// ErrMigrationPanic is returned when goose panics while running a migration.
+var ErrMigrationPanic = errors.New("migration panicked")
+
+// Migrator applies the embedded migrations to a database. Operations that change the schema
+// hold a Postgres advisory lock, so replicas starting together apply each migration once.
+type Migrator struct {
+	db       *pgxpool.Pool
+	provider *goose.Provider
+}
+
+// NewMigrator creates a Migrator for the database behind the pool. Schema changes wait up to
+// lockTimeout for the advisory lock held by another instance.
+func NewMigrator(p *pgxpool.Pool, lockTimeout time.Duration) (*Migrator, error) {
 	fsys, err := fs.Sub(embedMigrations, "migrations")
 	if err != nil {
-		return fmt.Errorf("failed to get subdirectory: %w", err)
+		return nil, fmt.Errorf("failed to get subdirectory: %w", err)
 	}
 
-	goose.SetBaseFS(fsys)
+	// The locker retries once per second until the timeout is reached.
+	locker, err := lock.NewPostgresSessionLocker(lock.WithLockTimeout(1, uint64(max(lockTimeout/time.Second, 1))))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration lock: %w", err)
+	}
 
-	if err := goose.SetDialect(string(goose.DialectPostgres)); err != nil {
-		return errors.Join(fmt.Errorf("failed to set goose dialect to postgres"), err)
+	provider, err := goose.NewProvider(goose.DialectPostgres, stdlib.OpenDBFromPool(p), fsys,
+		goose.WithSessionLocker(locker))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration provider: %w", err)
 	}
 
-	return nil
+	return &Migrator{db: p, provider: provider}, nil
 }
 
-// RunMigrations We might actually have to consider a recover, since goose does panic
-func RunMigrations(p *pgxpool.Pool) error {
-	return run(stdlib.OpenDBFromPool(p))
+// Up applies every pending migration and returns the ones applied.
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	results, err := guard("up", func() ([]*goose.MigrationResult, error) { return m.provider.Up(ctx) })
+	if err != nil {
+		return results, fmt.Errorf("failed to run up migrations: %w", err)
+	}
+	return results, nil
+}
+
+// Down rolls back the most recently applied migration.
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	result, err := guard("down", func() (*goose.MigrationResult, error) { return m.provider.Down(ctx) })
+	if err != nil {
+		return result, fmt.Errorf("failed to run down migration: %w", err)
+	}
+	return result, nil
 }
 
-// Run runs all pending migrations
-func run(db *sql.DB) error {
-	if err := setup(); err != nil {
-		return err
+// Redo rolls back the most recently applied migration and applies it again.
+func (m *Migrator) Redo(ctx context.Context) ([]*goose.MigrationResult, error) {
+	down, err := m.Down(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	up, err := guard("redo", func() (*goose.MigrationResult, error) {
+		return m.provider.ApplyVersion(ctx, down.Source.Version, true)
+	})
+	if err != nil {
+		return []*goose.MigrationResult{down}, fmt.Errorf("failed to re-apply migration %d: %w", down.Source.Version, err)
 	}
+	return []*goose.MigrationResult{down, up}, nil
+}
 
-	if err := goose.Up(db, "."); err != nil {
-		return fmt.Errorf("failed to run up migrations: %w", err)
+// Status returns every known migration and whether it has been applied.
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	statuses, err := guard("status", func() ([]*goose.MigrationStatus, error) { return m.provider.Status(ctx) })
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migration status: %w", err)
 	}
+	return statuses, nil
+}
 
+// MigrationState describes the schema version of a database against the embedded migrations.
+type MigrationState struct {
+	Current int64              `json:"current_version"`
+	Latest  int64              `json:"latest_version"`
+	Pending []PendingMigration `json:"pending"`
+}
+
+// PendingMigration is an embedded migration not yet applied to the database.
+type PendingMigration struct {
+	Version int64  `json:"version"`
+	Name    string `json:"name"`
+}
+
+// UpToDate returns true if no migration is pending.
+func (s MigrationState) UpToDate() bool {
+	return len(s.Pending) == 0
+}
+
+// State reports the current and latest schema versions and the pending migrations.
+// Unlike Status it only reads the version table, so it never waits for the migration lock
+// and is cheap enough for health checks.
+func (m *Migrator) State(ctx context.Context) (MigrationState, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return MigrationState{}, fmt.Errorf("failed to read migration state: %w", err)
+	}
+
+	state := MigrationState{Pending: []PendingMigration{}}
+	for _, src := range m.provider.ListSources() {
+		state.Latest = max(state.Latest, src.Version)
+		if applied[src.Version] {
+			state.Current = max(state.Current, src.Version)
+			continue
+		}
+		state.Pending = append(state.Pending, PendingMigration{Version: src.Version, Name: src.Path})
+	}
+	return state, nil
+}
+
+// appliedVersions returns the versions currently applied. goose appends a row for every apply
+// and rollback, so the latest row of each version decides. A database that was never migrated
+// has no version table and nothing applied.
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int64]bool, error) {
+	rows, err := m.db.Query(ctx, "SELECT version_id, is_applied FROM "+goose.DefaultTablename+" ORDER BY id")
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == undefinedTable {
+		return map[int64]bool{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]bool)
+	for rows.Next() {
+		var version int64
+		var isApplied bool
+		if err := rows.Scan(&version, &isApplied); err != nil {
+			return nil, err
+		}
+		applied[version] = isApplied
+	}
+	return applied, rows.Err()
+}
+
+// guard runs a goose operation, turning a panic into an error wrapping ErrMigrationPanic
+// so a broken migration cannot crash the process.
+func guard[T any](op string, fn func() (T, error)) (result T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w during %s: %v", ErrMigrationPanic, op, r)
+		}
+	}()
+	return fn()
+}
+
+// Close releases the migrator's database handle. The pool itself is left open.
+func (m *Migrator) Close() error {
+	return m.provider.Close()
+}
+
+// CreateMigration writes a new, timestamped SQL migration named after name into dir.
+func CreateMigration(dir, name string) error {
+	if err := goose.Create(nil, dir, name, "sql"); err != nil {
+		return fmt.Errorf("failed to create migration: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE skills
+    ADD COLUMN created_at TIMESTAMPTZ DEFAULT NOW(),
+    ADD COLUMN updated_at TIMESTAMPTZ DEFAULT NOW();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE skills
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS created_at;
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every write to CV content notifies the cv_changed channel, so each instance flushes its
+-- read cache. NOTIFY is delivered on commit and deduplicated within a transaction, so a
+-- whole seed run sends one notification per changed table, and a rolled back run none.
+CREATE FUNCTION notify_cv_changed() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('cv_changed', TG_TABLE_NAME);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'skills', 'experiences', 'projects', 'achievements',
+        'experience_skills', 'project_skills', 'achievement_skills', 'experience_projects'
+    ] LOOP
+        EXECUTE format(
+            'CREATE TRIGGER %I AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON %I
+             FOR EACH STATEMENT EXECUTE FUNCTION notify_cv_changed()',
+            t || '_notify_cv_changed', t);
+    END LOOP;
+END;
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'skills', 'experiences', 'projects', 'achievements',
+        'experience_skills', 'project_skills', 'achievement_skills', 'experience_projects'
+    ] LOOP
+        EXECUTE format('DROP TRIGGER IF EXISTS %I ON %I', t || '_notify_cv_changed', t);
+    END LOOP;
+END;
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS notify_cv_changed();
+-- +goose StatementEnd
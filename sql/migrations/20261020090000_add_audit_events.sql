@@ -0,0 +1,82 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every change to a CV entity is recorded by a trigger, so it is written in the transaction of
+-- the change itself and rolled back with it. The actor is read from the transaction-local
+-- app.audit_actor setting, falling back to the database user for changes made outside the app.
+-- before and after hold only the columns that changed; created_at and updated_at are left out.
+-- Links between records are audited by audit_cv_link, added in a later migration.
+CREATE TABLE audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor TEXT NOT NULL,
+    entity TEXT NOT NULL,
+    entity_id INT NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    before JSONB,                     -- NULL on create
+    after JSONB                       -- NULL on delete
+);
+
+CREATE INDEX idx_audit_events_entity ON audit_events(entity, id);
+CREATE INDEX idx_audit_events_occurred_at ON audit_events(occurred_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION audit_cv_change() RETURNS trigger AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    row_id INT;
+    change TEXT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        change := 'create';
+        row_id := NEW.id;
+        new_row := to_jsonb(NEW) - 'created_at' - 'updated_at';
+    ELSIF TG_OP = 'DELETE' THEN
+        change := 'delete';
+        row_id := OLD.id;
+        old_row := to_jsonb(OLD) - 'created_at' - 'updated_at';
+    ELSE
+        change := 'update';
+        row_id := NEW.id;
+        SELECT jsonb_object_agg(o.key, o.value), jsonb_object_agg(o.key, n.value)
+        INTO old_row, new_row
+        FROM jsonb_each(to_jsonb(OLD) - 'created_at' - 'updated_at') o
+        JOIN jsonb_each(to_jsonb(NEW)) n USING (key)
+        WHERE o.value IS DISTINCT FROM n.value;
+        -- Nothing but the timestamps changed.
+        IF old_row IS NULL THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    INSERT INTO audit_events (actor, entity, entity_id, action, before, after)
+    VALUES (
+        COALESCE(NULLIF(current_setting('app.audit_actor', true), ''), session_user),
+        TG_ARGV[0], row_id, change, old_row, new_row
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER skills_audit AFTER INSERT OR UPDATE OR DELETE ON skills
+    FOR EACH ROW EXECUTE FUNCTION audit_cv_change('skill');
+CREATE TRIGGER experiences_audit AFTER INSERT OR UPDATE OR DELETE ON experiences
+    FOR EACH ROW EXECUTE FUNCTION audit_cv_change('experience');
+CREATE TRIGGER achievements_audit AFTER INSERT OR UPDATE OR DELETE ON achievements
+    FOR EACH ROW EXECUTE FUNCTION audit_cv_change('achievement');
+CREATE TRIGGER projects_audit AFTER INSERT OR UPDATE OR DELETE ON projects
+    FOR EACH ROW EXECUTE FUNCTION audit_cv_change('project');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS projects_audit ON projects;
+DROP TRIGGER IF EXISTS achievements_audit ON achievements;
+DROP TRIGGER IF EXISTS experiences_audit ON experiences;
+DROP TRIGGER IF EXISTS skills_audit ON skills;
+DROP FUNCTION IF EXISTS audit_cv_change();
+DROP TABLE IF EXISTS audit_events;
+-- +goose StatementEnd
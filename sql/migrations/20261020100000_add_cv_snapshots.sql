@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Snapshots freeze the whole CV as a JSON document, to know exactly what was sent when. They
+-- are numbered by version and may carry a unique label, e.g. acme-2026-10.
+CREATE TABLE cv_snapshots (
+    version SERIAL PRIMARY KEY,
+    label TEXT UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    content JSONB NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Snapshots are immutable: once taken, they can be neither changed nor deleted.
+CREATE FUNCTION reject_snapshot_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'cv snapshot % is immutable', OLD.version;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER cv_snapshots_immutable BEFORE UPDATE OR DELETE ON cv_snapshots
+    FOR EACH ROW EXECUTE FUNCTION reject_snapshot_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cv_snapshots;
+DROP FUNCTION IF EXISTS reject_snapshot_change();
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- Every CV entity can be hidden from the public CV, and is soft-deleted rather than removed,
+-- so it can be brought back. Public queries only read public rows whose deleted_at is NULL.
+-- +goose StatementBegin
+ALTER TABLE skills
+    ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'unlisted', 'private')),
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE experiences
+    ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'unlisted', 'private')),
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE achievements
+    ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'unlisted', 'private')),
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE projects
+    ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'unlisted', 'private')),
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE projects DROP COLUMN IF EXISTS deleted_at, DROP COLUMN IF EXISTS visibility;
+ALTER TABLE achievements DROP COLUMN IF EXISTS deleted_at, DROP COLUMN IF EXISTS visibility;
+ALTER TABLE experiences DROP COLUMN IF EXISTS deleted_at, DROP COLUMN IF EXISTS visibility;
+ALTER TABLE skills DROP COLUMN IF EXISTS deleted_at, DROP COLUMN IF EXISTS visibility;
+-- +goose StatementEnd
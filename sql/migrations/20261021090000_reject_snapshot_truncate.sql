@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The row triggers do not see TRUNCATE, which would otherwise empty the table at once.
+CREATE OR REPLACE FUNCTION reject_snapshot_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'TRUNCATE' THEN
+        RAISE EXCEPTION 'cv snapshots are immutable';
+    END IF;
+    RAISE EXCEPTION 'cv snapshot % is immutable', OLD.version;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER cv_snapshots_no_truncate BEFORE TRUNCATE ON cv_snapshots
+    FOR EACH STATEMENT EXECUTE FUNCTION reject_snapshot_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS cv_snapshots_no_truncate ON cv_snapshots;
+CREATE OR REPLACE FUNCTION reject_snapshot_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'cv snapshot % is immutable', OLD.version;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Links between records are audited too. A link has no id of its own, so its event carries
+-- the id of the record it belongs to, e.g. the experience of an experience skill, and the
+-- whole link row in before or after.
+CREATE FUNCTION audit_cv_link() RETURNS trigger AS $$
+DECLARE
+    link JSONB;
+    change TEXT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        change := 'create';
+        link := to_jsonb(NEW);
+    ELSIF TG_OP = 'DELETE' THEN
+        change := 'delete';
+        link := to_jsonb(OLD);
+    ELSE
+        change := 'update';
+        link := to_jsonb(NEW);
+    END IF;
+
+    INSERT INTO audit_events (actor, entity, entity_id, action, before, after)
+    VALUES (
+        COALESCE(NULLIF(current_setting('app.audit_actor', true), ''), session_user),
+        TG_ARGV[0], (link ->> TG_ARGV[1])::INT, change,
+        CASE WHEN TG_OP <> 'INSERT' THEN to_jsonb(OLD) END,
+        CASE WHEN TG_OP <> 'DELETE' THEN to_jsonb(NEW) END
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER experience_skills_audit AFTER INSERT OR UPDATE OR DELETE ON experience_skills
+    FOR EACH ROW EXECUTE FUNCTION audit_cv_link('experience_skill', 'experience_id');
+CREATE TRIGGER project_skills_audit AFTER INSERT OR UPDATE OR DELETE ON project_skills
+    FOR EACH ROW EXECUTE FUNCTION audit_cv_link('project_skill', 'project_id');
+CREATE TRIGGER achievement_skills_audit AFTER INSERT OR UPDATE OR DELETE ON achievement_skills
+    FOR EACH ROW EXECUTE FUNCTION audit_cv_link('achievement_skill', 'achievement_id');
+CREATE TRIGGER experience_projects_audit AFTER INSERT OR UPDATE OR DELETE ON experience_projects
+    FOR EACH ROW EXECUTE FUNCTION audit_cv_link('experience_project', 'experience_id');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS experience_projects_audit ON experience_projects;
+DROP TRIGGER IF EXISTS achievement_skills_audit ON achievement_skills;
+DROP TRIGGER IF EXISTS project_skills_audit ON project_skills;
+DROP TRIGGER IF EXISTS experience_skills_audit ON experience_skills;
+DROP FUNCTION IF EXISTS audit_cv_link();
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+package sql
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationsHaveUpAndDown(t *testing.T) {
+	files, err := fs.Glob(embedMigrations, "migrations/*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		content, err := fs.ReadFile(embedMigrations, file)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "-- +goose Up", file)
+		assert.Contains(t, string(content), "-- +goose Down", file)
+	}
+}
+
+var errBoom = errors.New("boom")
+
+func TestGuard(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func() (int, error)
+		expected int
+		err      error
+	}{
+		{"result", func() (int, error) { return 1, nil }, 1, nil},
+		{"error", func() (int, error) { return 0, errBoom }, 0, errBoom},
+		{"panic", func() (int, error) { panic("goose: no migrations") }, 0, ErrMigrationPanic},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := guard("up", tt.fn)
+			assert.Equal(t, tt.expected, result)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -1,23 +1,36 @@
 -- name: ListAchievements :many
-SELECT * FROM achievements ORDER BY date DESC NULLS LAST;
+-- An achievement is only public if the experience and project it belongs to are too.
+SELECT * FROM achievements a
+WHERE NOT sqlc.arg(public_only)::boolean OR (
+    a.visibility = 'public' AND a.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM experiences e
+        WHERE e.id = a.experience_id AND (e.visibility <> 'public' OR e.deleted_at IS NOT NULL)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM projects p
+        WHERE p.id = a.project_id AND (p.visibility <> 'public' OR p.deleted_at IS NOT NULL)
+    )
+)
+ORDER BY a.date DESC NULLS LAST;
 
 -- name: GetAchievement :one
 SELECT * FROM achievements WHERE id = $1;
 
 -- name: CreateAchievement :one
-INSERT INTO achievements (title, description, date, experience_id, project_id)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO achievements (title, description, date, experience_id, project_id, visibility)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: UpdateAchievement :one
 UPDATE achievements
 SET title = $2, description = $3, date = $4, experience_id = $5,
-    project_id = $6, updated_at = NOW()
+    project_id = $6, visibility = $7, deleted_at = NULL, updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
 -- name: DeleteAchievement :exec
-DELETE FROM achievements WHERE id = $1;
+UPDATE achievements SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL;
 
 -- Skill linking
 -- name: AddSkillToAchievement :exec
@@ -30,6 +43,7 @@ DELETE FROM achievement_skills WHERE achievement_id = $1 AND skill_id = $2;
 SELECT s.* FROM skills s
 JOIN achievement_skills aks ON s.id = aks.skill_id
 WHERE aks.achievement_id = $1
+  AND (NOT sqlc.arg(public_only)::boolean OR (s.visibility = 'public' AND s.deleted_at IS NULL))
 ORDER BY s.category, s.name;
 
 -- name: ListAchievementsForSkill :many
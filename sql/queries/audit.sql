@@ -0,0 +1,13 @@
+-- name: SetAuditActor :exec
+-- Attributes the changes of the current transaction to an actor in audit_events.
+SELECT set_config('app.audit_actor', sqlc.arg(actor)::text, true);
+
+-- name: ListAuditEvents :many
+-- Newest first; cursor is the ID of the last event of the previous page.
+SELECT * FROM audit_events
+WHERE (sqlc.narg(entity)::text IS NULL OR entity = sqlc.narg(entity))
+  AND (sqlc.narg(since)::timestamptz IS NULL OR occurred_at >= sqlc.narg(since))
+  AND (sqlc.narg(until)::timestamptz IS NULL OR occurred_at < sqlc.narg(until))
+  AND (sqlc.narg(cursor)::bigint IS NULL OR id < sqlc.narg(cursor))
+ORDER BY id DESC
+LIMIT sqlc.arg(max_results);
@@ -1,23 +1,25 @@
 -- name: ListExperiences :many
-SELECT * FROM experiences ORDER BY start_date DESC;
+SELECT * FROM experiences
+WHERE NOT sqlc.arg(public_only)::boolean OR (visibility = 'public' AND deleted_at IS NULL)
+ORDER BY start_date DESC;
 
 -- name: GetExperience :one
 SELECT * FROM experiences WHERE id = $1;
 
 -- name: CreateExperience :one
-INSERT INTO experiences (company_name, job_title, location, start_date, end_date, description, highlights)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO experiences (company_name, job_title, location, start_date, end_date, description, highlights, visibility)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING *;
 
 -- name: UpdateExperience :one
 UPDATE experiences
 SET company_name = $2, job_title = $3, location = $4, start_date = $5, end_date = $6,
-    description = $7, highlights = $8, updated_at = NOW()
+    description = $7, highlights = $8, visibility = $9, deleted_at = NULL, updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
 -- name: DeleteExperience :exec
-DELETE FROM experiences WHERE id = $1;
+UPDATE experiences SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL;
 
 -- Skill linking
 -- name: AddSkillToExperience :exec
@@ -30,6 +32,7 @@ DELETE FROM experience_skills WHERE experience_id = $1 AND skill_id = $2;
 SELECT s.* FROM skills s
 JOIN experience_skills es ON s.id = es.skill_id
 WHERE es.experience_id = $1
+  AND (NOT sqlc.arg(public_only)::boolean OR (s.visibility = 'public' AND s.deleted_at IS NULL))
 ORDER BY s.category, s.name;
 
 -- name: ListExperiencesForSkill :many
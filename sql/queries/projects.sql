@@ -1,22 +1,24 @@
 -- name: ListProjects :many
-SELECT * FROM projects ORDER BY start_date DESC NULLS LAST;
+SELECT * FROM projects
+WHERE NOT sqlc.arg(public_only)::boolean OR (visibility = 'public' AND deleted_at IS NULL)
+ORDER BY start_date DESC NULLS LAST;
 
 -- name: GetProject :one
 SELECT * FROM projects WHERE id = $1;
 
 -- name: CreateProject :one
-INSERT INTO projects (name, description, start_date, end_date)
-VALUES ($1, $2, $3, $4)
+INSERT INTO projects (name, description, start_date, end_date, visibility)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: UpdateProject :one
 UPDATE projects
-SET name = $2, description = $3, start_date = $4, end_date = $5, updated_at = NOW()
+SET name = $2, description = $3, start_date = $4, end_date = $5, visibility = $6, deleted_at = NULL, updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
 -- name: DeleteProject :exec
-DELETE FROM projects WHERE id = $1;
+UPDATE projects SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL;
 
 -- Skill linking
 -- name: AddSkillToProject :exec
@@ -29,6 +31,7 @@ DELETE FROM project_skills WHERE project_id = $1 AND skill_id = $2;
 SELECT s.* FROM skills s
 JOIN project_skills ps ON s.id = ps.skill_id
 WHERE ps.project_id = $1
+  AND (NOT sqlc.arg(public_only)::boolean OR (s.visibility = 'public' AND s.deleted_at IS NULL))
 ORDER BY s.category, s.name;
 
 -- name: ListProjectsForSkill :many
@@ -1,14 +1,19 @@
 -- name: ListSkills :many
-SELECT * FROM skills ORDER BY category, name;
+SELECT * FROM skills
+WHERE NOT sqlc.arg(public_only)::boolean OR (visibility = 'public' AND deleted_at IS NULL)
+ORDER BY category, name;
 
 -- name: GetSkillByName :one
 SELECT * FROM skills WHERE name = $1;
 
 -- name: CreateSkill :one
-INSERT INTO skills (name, category, proficiency, logo_url)
-VALUES ($1, $2, $3, $4)
+INSERT INTO skills (name, category, proficiency, logo_url, visibility)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
+-- name: DeleteSkill :exec
+UPDATE skills SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL;
+
 -- name: UpdateSkill :one
-UPDATE skills SET category = $2, proficiency = $3, logo_url = $4, updated_at = NOW()
+UPDATE skills SET category = $2, proficiency = $3, logo_url = $4, visibility = $5, deleted_at = NULL, updated_at = NOW()
 WHERE id = $1 RETURNING *;
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- name: CreateSnapshot :one
+INSERT INTO cv_snapshots (label, content)
+VALUES ($1, $2)
+RETURNING version, label, created_at;
+
+-- name: ListSnapshots :many
+SELECT version, label, created_at FROM cv_snapshots ORDER BY version DESC;
+
+-- name: GetSnapshot :one
+SELECT * FROM cv_snapshots WHERE version = $1;
+
+-- name: GetSnapshotByLabel :one
+SELECT * FROM cv_snapshots WHERE label = $1;
@@ -0,0 +1,19 @@
+// Package ui holds the HTML themes and static assets, embedded at compile time so the binary
+// serves its pages from any working directory.
+package ui
+
+import "embed"
+
+// DefaultTheme is the complete theme every other theme is layered over.
+const DefaultTheme = "default"
+
+// Themes holds one directory per built-in theme, e.g. themes/default/index.html. A theme other
+// than the default only contains the templates it replaces.
+//
+//go:embed themes
+var Themes embed.FS
+
+// Assets holds the static assets, e.g. assets/logos/go.svg.
+//
+//go:embed assets
+var Assets embed.FS